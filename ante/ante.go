@@ -24,6 +24,12 @@ import (
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 
 	gaiaerrors "github.com/maany-xyz/maany-provider/types/errors"
+	circuitbreakerkeeper "github.com/maany-xyz/maany-provider/x/circuitbreaker/keeper"
+	expeditedkeeper "github.com/maany-xyz/maany-provider/x/expedited/keeper"
+	metaprotocolskeeper "github.com/maany-xyz/maany-provider/x/metaprotocols/keeper"
+	mintburnkeeper "github.com/maany-xyz/maany-provider/x/mintburn/keeper"
+	relayerlanekeeper "github.com/maany-xyz/maany-provider/x/relayerlane/keeper"
+	votestakekeeper "github.com/maany-xyz/maany-provider/x/votestake/keeper"
 )
 
 // UseFeeMarketDecorator to make the integration testing easier: we can switch off its ante and post decorators with this flag
@@ -46,6 +52,12 @@ type HandlerOptions struct {
 	TxFeeChecker          ante.TxFeeChecker
 	TXCounterStoreService corestoretypes.KVStoreService
 	WasmConfig            *wasmtypes.WasmConfig
+	MetaprotocolsKeeper   *metaprotocolskeeper.Keeper
+	ExpeditedKeeper       *expeditedkeeper.Keeper
+	VoteStakeKeeper       *votestakekeeper.Keeper
+	MintburnKeeper        *mintburnkeeper.Keeper
+	CircuitBreakerKeeper  *circuitbreakerkeeper.Keeper
+	RelayerLaneKeeper     *relayerlanekeeper.Keeper
 }
 
 func NewAnteHandler(opts HandlerOptions) (sdk.AnteHandler, error) {
@@ -68,6 +80,24 @@ func NewAnteHandler(opts HandlerOptions) (sdk.AnteHandler, error) {
 	if opts.StakingKeeper == nil {
 		return nil, errorsmod.Wrap(gaiaerrors.ErrNotFound, "staking param store is required for AnteHandler")
 	}
+	if opts.MetaprotocolsKeeper == nil {
+		return nil, errorsmod.Wrap(gaiaerrors.ErrLogic, "metaprotocols keeper is required for AnteHandler")
+	}
+	if opts.ExpeditedKeeper == nil {
+		return nil, errorsmod.Wrap(gaiaerrors.ErrLogic, "expedited keeper is required for AnteHandler")
+	}
+	if opts.VoteStakeKeeper == nil {
+		return nil, errorsmod.Wrap(gaiaerrors.ErrLogic, "votestake keeper is required for AnteHandler")
+	}
+	if opts.MintburnKeeper == nil {
+		return nil, errorsmod.Wrap(gaiaerrors.ErrLogic, "mintburn keeper is required for AnteHandler")
+	}
+	if opts.CircuitBreakerKeeper == nil {
+		return nil, errorsmod.Wrap(gaiaerrors.ErrLogic, "circuitbreaker keeper is required for AnteHandler")
+	}
+	if opts.RelayerLaneKeeper == nil {
+		return nil, errorsmod.Wrap(gaiaerrors.ErrLogic, "relayerlane keeper is required for AnteHandler")
+	}
 
 	sigGasConsumer := opts.SigGasConsumer
 	if sigGasConsumer == nil {
@@ -83,8 +113,11 @@ func NewAnteHandler(opts HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(opts.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(opts.AccountKeeper),
-		NewGovVoteDecorator(opts.Codec, opts.StakingKeeper),
-		NewGovExpeditedProposalsDecorator(opts.Codec),
+		NewCircuitBreakerDecorator(opts.Codec, opts.CircuitBreakerKeeper),
+		NewMetaprotocolsDecorator(opts.MetaprotocolsKeeper),
+		NewGovVoteDecorator(opts.Codec, opts.VoteStakeKeeper),
+		NewGovExpeditedProposalsDecorator(opts.Codec, opts.ExpeditedKeeper),
+		NewMintburnDecorator(opts.Codec, opts.MintburnKeeper),
 		ante.NewSetPubKeyDecorator(opts.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewValidateSigCountDecorator(opts.AccountKeeper),
 		ante.NewSigGasConsumeDecorator(opts.AccountKeeper, sigGasConsumer),
@@ -95,16 +128,17 @@ func NewAnteHandler(opts HandlerOptions) (sdk.AnteHandler, error) {
 
 	if UseFeeMarketDecorator {
 		anteDecorators = append(anteDecorators,
-			feemarketante.NewFeeMarketCheckDecorator(
-				opts.AccountKeeper,
-				opts.BankKeeper,
-				opts.FeegrantKeeper,
-				opts.FeeMarketKeeper,
-				ante.NewDeductFeeDecorator(
+			NewRelayerLaneDecorator(opts.RelayerLaneKeeper,
+				feemarketante.NewFeeMarketCheckDecorator(
 					opts.AccountKeeper,
 					opts.BankKeeper,
 					opts.FeegrantKeeper,
-					opts.TxFeeChecker)))
+					relayerlanekeeper.NewLaneFeeMarketKeeper(opts.FeeMarketKeeper),
+					ante.NewDeductFeeDecorator(
+						opts.AccountKeeper,
+						opts.BankKeeper,
+						opts.FeegrantKeeper,
+						opts.TxFeeChecker))))
 	}
 
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
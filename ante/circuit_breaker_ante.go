@@ -0,0 +1,55 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	gaiaerrors "github.com/maany-xyz/maany-provider/types/errors"
+	circuitbreakerkeeper "github.com/maany-xyz/maany-provider/x/circuitbreaker/keeper"
+)
+
+// CircuitBreakerDecorator rejects txs containing a msg type paused in
+// x/circuitbreaker by governance or a guardian, including msgs wrapped in an
+// authz MsgExec.
+type CircuitBreakerDecorator struct {
+	cdc    codec.BinaryCodec
+	keeper *circuitbreakerkeeper.Keeper
+}
+
+func NewCircuitBreakerDecorator(cdc codec.BinaryCodec, keeper *circuitbreakerkeeper.Keeper) CircuitBreakerDecorator {
+	return CircuitBreakerDecorator{
+		cdc:    cdc,
+		keeper: keeper,
+	}
+}
+
+func (c CircuitBreakerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	for _, msg := range tx.GetMsgs() {
+		if err := c.validateMsg(ctx, msg); err != nil {
+			return ctx, err
+		}
+	}
+	return next(ctx, tx, simulate)
+}
+
+func (c CircuitBreakerDecorator) validateMsg(ctx sdk.Context, msg sdk.Msg) error {
+	msgType := sdk.MsgTypeURL(msg)
+	if c.keeper.IsMsgTypePaused(ctx, msgType) {
+		return errorsmod.Wrapf(gaiaerrors.ErrPaused, "msg type %s", msgType)
+	}
+	if exec, ok := msg.(*authz.MsgExec); ok {
+		for _, anyMsg := range exec.Msgs {
+			var innerMsg sdk.Msg
+			if err := c.cdc.UnpackAny(anyMsg, &innerMsg); err != nil {
+				return errorsmod.Wrap(gaiaerrors.ErrUnauthorized, "cannot unmarshal authz exec msgs")
+			}
+			if err := c.validateMsg(ctx, innerMsg); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/maany-xyz/maany-provider/ante"
+	"github.com/maany-xyz/maany-provider/app/helpers"
+	gaiaerrors "github.com/maany-xyz/maany-provider/types/errors"
+	mintburntypes "github.com/maany-xyz/maany-provider/x/mintburn/types"
+)
+
+func TestCircuitBreakerDecorator(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+	decorator := ante.NewCircuitBreakerDecorator(gaiaApp.AppCodec(), &gaiaApp.CircuitBreakerKeeper)
+
+	sender := sdk.AccAddress("escrow_sender_______")
+	escrow := &mintburntypes.MsgEscrowInitial{
+		Sender:          sender.String(),
+		ConsumerChainId: "consumer-1",
+		Amount:          sdk.NewInt64Coin("stake", 1000),
+	}
+	send := banktypes.NewMsgSend(sender, sender, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	anteHandle := func(msgs ...sdk.Msg) error {
+		txBuilder := gaiaApp.GetTxConfig().NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msgs...))
+		_, err := decorator.AnteHandle(ctx, txBuilder.GetTx(), false,
+			func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil })
+		return err
+	}
+
+	require.NoError(t, anteHandle(escrow, send))
+
+	gaiaApp.CircuitBreakerKeeper.SetMsgTypePaused(ctx, sdk.MsgTypeURL(escrow), true)
+	require.ErrorIs(t, anteHandle(escrow), gaiaerrors.ErrPaused)
+	require.ErrorIs(t, anteHandle(send, escrow), gaiaerrors.ErrPaused)
+	require.ErrorIs(t, anteHandle(newAuthzExec([]sdk.Msg{escrow})), gaiaerrors.ErrPaused)
+	require.NoError(t, anteHandle(send))
+
+	gaiaApp.CircuitBreakerKeeper.SetMsgTypePaused(ctx, sdk.MsgTypeURL(escrow), false)
+	require.NoError(t, anteHandle(escrow))
+}
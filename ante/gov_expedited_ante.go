@@ -5,9 +5,11 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 
 	gaiaerrors "github.com/maany-xyz/maany-provider/types/errors"
+	expeditedkeeper "github.com/maany-xyz/maany-provider/x/expedited/keeper"
 )
 
 var expeditedPropDecoratorEnabled = true
@@ -18,19 +20,17 @@ func SetExpeditedProposalsEnabled(val bool) {
 	expeditedPropDecoratorEnabled = val
 }
 
-var expeditedPropsWhitelist = map[string]struct{}{
-	"/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade": {},
-	"/cosmos.upgrade.v1beta1.MsgCancelUpgrade":   {},
-}
-
 // Check if the proposal is whitelisted for expedited voting.
+// The whitelist is kept in the x/expedited params and can be updated by governance.
 type GovExpeditedProposalsDecorator struct {
-	cdc codec.BinaryCodec
+	cdc    codec.BinaryCodec
+	keeper *expeditedkeeper.Keeper
 }
 
-func NewGovExpeditedProposalsDecorator(cdc codec.BinaryCodec) GovExpeditedProposalsDecorator {
+func NewGovExpeditedProposalsDecorator(cdc codec.BinaryCodec, keeper *expeditedkeeper.Keeper) GovExpeditedProposalsDecorator {
 	return GovExpeditedProposalsDecorator{
-		cdc: cdc,
+		cdc:    cdc,
+		keeper: keeper,
 	}
 }
 
@@ -40,39 +40,50 @@ func NewGovExpeditedProposalsDecorator(cdc codec.BinaryCodec) GovExpeditedPropos
 func (g GovExpeditedProposalsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	if expeditedPropDecoratorEnabled {
 		for _, msg := range tx.GetMsgs() {
-			prop, ok := msg.(*govv1.MsgSubmitProposal)
-			if !ok {
-				continue
-			}
-			if prop.Expedited {
-				if err := g.validateExpeditedGovProp(prop); err != nil {
-					return ctx, err
-				}
+			if err := g.validateMsg(ctx, msg); err != nil {
+				return ctx, err
 			}
 		}
 	}
 	return next(ctx, tx, simulate)
 }
 
-func (g GovExpeditedProposalsDecorator) isWhitelisted(msgType string) bool {
-	_, ok := expeditedPropsWhitelist[msgType]
-	return ok
+// validateMsg checks expedited proposals, including those wrapped in an authz MsgExec.
+func (g GovExpeditedProposalsDecorator) validateMsg(ctx sdk.Context, msg sdk.Msg) error {
+	switch m := msg.(type) {
+	case *govv1.MsgSubmitProposal:
+		if m.Expedited {
+			return g.validateExpeditedGovProp(ctx, m)
+		}
+	case *authz.MsgExec:
+		for _, anyMsg := range m.Msgs {
+			var innerMsg sdk.Msg
+			if err := g.cdc.UnpackAny(anyMsg, &innerMsg); err != nil {
+				return errorsmod.Wrap(gaiaerrors.ErrUnauthorized, "cannot unmarshal authz exec msgs")
+			}
+			if err := g.validateMsg(ctx, innerMsg); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
-func (g GovExpeditedProposalsDecorator) validateExpeditedGovProp(prop *govv1.MsgSubmitProposal) error {
+func (g GovExpeditedProposalsDecorator) validateExpeditedGovProp(ctx sdk.Context, prop *govv1.MsgSubmitProposal) error {
 	msgs := prop.GetMessages()
 	if len(msgs) == 0 {
 		return gaiaerrors.ErrInvalidExpeditedProposal
 	}
+	whitelist := g.keeper.GetParams(ctx)
 	for _, message := range msgs {
 		// in case of legacy content submitted using govv1.MsgSubmitProposal
 		if sdkMsg, isLegacy := message.GetCachedValue().(*govv1.MsgExecLegacyContent); isLegacy {
-			if !g.isWhitelisted(sdkMsg.Content.TypeUrl) {
+			if !whitelist.IsWhitelisted(sdkMsg.Content.TypeUrl) {
 				return errorsmod.Wrapf(gaiaerrors.ErrInvalidExpeditedProposal, "invalid Msg type: %s", sdkMsg.Content.TypeUrl)
 			}
 			continue
 		}
-		if !g.isWhitelisted(message.TypeUrl) {
+		if !whitelist.IsWhitelisted(message.TypeUrl) {
 			return errorsmod.Wrapf(gaiaerrors.ErrInvalidExpeditedProposal, "invalid Msg type: %s", message.TypeUrl)
 		}
 	}
@@ -5,10 +5,13 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
 	"cosmossdk.io/math"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
@@ -16,6 +19,7 @@ import (
 
 	"github.com/maany-xyz/maany-provider/ante"
 	"github.com/maany-xyz/maany-provider/app/helpers"
+	expeditedtypes "github.com/maany-xyz/maany-provider/x/expedited/types"
 )
 
 func TestGovExpeditedProposalsDecorator(t *testing.T) {
@@ -23,14 +27,12 @@ func TestGovExpeditedProposalsDecorator(t *testing.T) {
 
 	testCases := []struct {
 		name      string
-		ctx       sdk.Context
 		msgs      []sdk.Msg
 		expectErr bool
 	}{
 		// these cases should pass
 		{
 			name: "expedited - govv1.MsgSubmitProposal - MsgSoftwareUpgrade",
-			ctx:  sdk.Context{},
 			msgs: []sdk.Msg{
 				newGovProp([]sdk.Msg{&upgradetypes.MsgSoftwareUpgrade{
 					Authority: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
@@ -45,7 +47,6 @@ func TestGovExpeditedProposalsDecorator(t *testing.T) {
 		},
 		{
 			name: "expedited - govv1.MsgSubmitProposal - MsgCancelUpgrade",
-			ctx:  sdk.Context{},
 			msgs: []sdk.Msg{
 				newGovProp([]sdk.Msg{&upgradetypes.MsgCancelUpgrade{
 					Authority: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
@@ -55,7 +56,6 @@ func TestGovExpeditedProposalsDecorator(t *testing.T) {
 		},
 		{
 			name: "normal - govv1.MsgSubmitProposal - TextProposal",
-			ctx:  sdk.Context{},
 			msgs: []sdk.Msg{
 				newLegacyTextProp(false), // normal
 			},
@@ -63,7 +63,6 @@ func TestGovExpeditedProposalsDecorator(t *testing.T) {
 		},
 		{
 			name: "normal - govv1.MsgSubmitProposal - MsgCommunityPoolSpend",
-			ctx:  sdk.Context{},
 			msgs: []sdk.Msg{
 				newGovProp([]sdk.Msg{&distrtypes.MsgCommunityPoolSpend{
 					Authority: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
@@ -75,7 +74,6 @@ func TestGovExpeditedProposalsDecorator(t *testing.T) {
 		},
 		{
 			name: "normal - govv1.MsgSubmitProposal - MsgTransfer",
-			ctx:  sdk.Context{},
 			msgs: []sdk.Msg{
 				newGovProp([]sdk.Msg{&banktypes.MsgSend{
 					FromAddress: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
@@ -87,7 +85,6 @@ func TestGovExpeditedProposalsDecorator(t *testing.T) {
 		},
 		{
 			name: "normal - govv1.MsgSubmitProposal - MsgUpdateParams",
-			ctx:  sdk.Context{},
 			msgs: []sdk.Msg{
 				newGovProp([]sdk.Msg{&banktypes.MsgUpdateParams{
 					Authority: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
@@ -99,13 +96,11 @@ func TestGovExpeditedProposalsDecorator(t *testing.T) {
 		// submitted using "gaiad tx gov submit-legacy-proposal"
 		{
 			name:      "normal - govv1beta.MsgSubmitProposal - LegacySoftwareUpgrade",
-			ctx:       sdk.Context{},
 			msgs:      []sdk.Msg{newGovV1BETA1LegacyUpgradeProp()},
 			expectErr: false,
 		},
 		{
 			name:      "normal - govv1beta.MsgSubmitProposal - LegacyCancelSoftwareUpgrade",
-			ctx:       sdk.Context{},
 			msgs:      []sdk.Msg{newGovV1BETA1LegacyCancelUpgradeProp()},
 			expectErr: false,
 		},
@@ -113,7 +108,6 @@ func TestGovExpeditedProposalsDecorator(t *testing.T) {
 		// these are normal proposals, not whitelisted for expedited voting
 		{
 			name: "fail - expedited - govv1.MsgSubmitProposal - Empty",
-			ctx:  sdk.Context{},
 			msgs: []sdk.Msg{
 				newGovProp([]sdk.Msg{}, true),
 			},
@@ -121,7 +115,6 @@ func TestGovExpeditedProposalsDecorator(t *testing.T) {
 		},
 		{
 			name: "fail - expedited - govv1.MsgSubmitProposal - TextProposal",
-			ctx:  sdk.Context{},
 			msgs: []sdk.Msg{
 				newLegacyTextProp(true), // expedite
 			},
@@ -129,7 +122,6 @@ func TestGovExpeditedProposalsDecorator(t *testing.T) {
 		},
 		{
 			name: "fail - expedited - govv1.MsgSubmitProposal - MsgCommunityPoolSpend",
-			ctx:  sdk.Context{},
 			msgs: []sdk.Msg{
 				newGovProp([]sdk.Msg{&distrtypes.MsgCommunityPoolSpend{
 					Authority: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
@@ -141,7 +133,6 @@ func TestGovExpeditedProposalsDecorator(t *testing.T) {
 		},
 		{
 			name: "fail - expedited - govv1.MsgSubmitProposal - MsgTransfer",
-			ctx:  sdk.Context{},
 			msgs: []sdk.Msg{
 				newGovProp([]sdk.Msg{&banktypes.MsgSend{
 					FromAddress: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
@@ -153,7 +144,6 @@ func TestGovExpeditedProposalsDecorator(t *testing.T) {
 		},
 		{
 			name: "fail - expedited - govv1.MsgSubmitProposal - MsgUpdateParams",
-			ctx:  sdk.Context{},
 			msgs: []sdk.Msg{
 				newGovProp([]sdk.Msg{&banktypes.MsgUpdateParams{
 					Authority: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
@@ -161,19 +151,31 @@ func TestGovExpeditedProposalsDecorator(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			name: "fail - expedited - authz.MsgExec - MsgCommunityPoolSpend",
+			msgs: []sdk.Msg{
+				newAuthzExec([]sdk.Msg{newGovProp([]sdk.Msg{&distrtypes.MsgCommunityPoolSpend{
+					Authority: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+					Recipient: sdk.AccAddress{}.String(),
+					Amount:    sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(100))),
+				}}, true)}),
+			},
+			expectErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
 		tc := tc
 
 		t.Run(tc.name, func(t *testing.T) {
+			ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
 			txCfg := gaiaApp.GetTxConfig()
-			decorator := ante.NewGovExpeditedProposalsDecorator(gaiaApp.AppCodec())
+			decorator := ante.NewGovExpeditedProposalsDecorator(gaiaApp.AppCodec(), &gaiaApp.ExpeditedKeeper)
 
 			txBuilder := txCfg.NewTxBuilder()
 			require.NoError(t, txBuilder.SetMsgs(tc.msgs...))
 
-			_, err := decorator.AnteHandle(tc.ctx, txBuilder.GetTx(), false,
+			_, err := decorator.AnteHandle(ctx, txBuilder.GetTx(), false,
 				func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil })
 			if tc.expectErr {
 				require.Error(t, err)
@@ -184,6 +186,44 @@ func TestGovExpeditedProposalsDecorator(t *testing.T) {
 	}
 }
 
+func TestGovExpeditedProposalsDecoratorUpdatedWhitelist(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+	decorator := ante.NewGovExpeditedProposalsDecorator(gaiaApp.AppCodec(), &gaiaApp.ExpeditedKeeper)
+
+	updateParams := newGovProp([]sdk.Msg{&banktypes.MsgUpdateParams{
+		Authority: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+	}}, true)
+	softwareUpgrade := newGovProp([]sdk.Msg{&upgradetypes.MsgSoftwareUpgrade{
+		Authority: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+		Plan:      upgradetypes.Plan{Name: "upgrade", Height: 123456789},
+	}}, true)
+
+	anteHandle := func(msg sdk.Msg) error {
+		txBuilder := gaiaApp.GetTxConfig().NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msg))
+		_, err := decorator.AnteHandle(ctx, txBuilder.GetTx(), false,
+			func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil })
+		return err
+	}
+
+	require.Error(t, anteHandle(updateParams))
+	require.NoError(t, anteHandle(softwareUpgrade))
+
+	// governance replaces the whitelist
+	require.NoError(t, gaiaApp.ExpeditedKeeper.SetParams(ctx, expeditedtypes.Params{
+		WhitelistedMsgTypes: []string{sdk.MsgTypeURL(&banktypes.MsgUpdateParams{})},
+	}))
+	require.NoError(t, anteHandle(updateParams))
+	require.NoError(t, anteHandle(newAuthzExec([]sdk.Msg{updateParams})))
+	require.Error(t, anteHandle(softwareUpgrade))
+}
+
+func newAuthzExec(msgs []sdk.Msg) *authz.MsgExec {
+	msg := authz.NewMsgExec(sdk.AccAddress{}, msgs)
+	return &msg
+}
+
 func newLegacyTextProp(expedite bool) *govv1.MsgSubmitProposal {
 	testProposal := govv1beta1.NewTextProposal("Proposal", "Test as normal proposal")
 	msgContent, err := govv1.NewLegacyContent(testProposal, "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn")
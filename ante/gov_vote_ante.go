@@ -9,32 +9,31 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
-	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
-	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	gaiaerrors "github.com/maany-xyz/maany-provider/types/errors"
+	votestakekeeper "github.com/maany-xyz/maany-provider/x/votestake/keeper"
 )
 
-var (
-	minStakedTokens       = math.LegacyNewDec(1000000) // 1_000_000 uatom (or 1 atom)
-	maxDelegationsChecked = 100                        // number of delegation to check for the minStakedTokens
-)
+// minStakedTokensOverride replaces the on-chain min_staked_tokens param when set.
+var minStakedTokensOverride *math.LegacyDec
 
-// SetMinStakedTokens sets the minimum amount of staked tokens required to vote
+// SetMinStakedTokens overrides the minimum amount of staked tokens required to vote
 // Should only be used in testing
 func SetMinStakedTokens(tokens math.LegacyDec) {
-	minStakedTokens = tokens
+	minStakedTokensOverride = &tokens
 }
 
+// GovVoteDecorator rejects votes from accounts with less stake than the
+// x/votestake min_staked_tokens param.
 type GovVoteDecorator struct {
-	stakingKeeper *stakingkeeper.Keeper
-	cdc           codec.BinaryCodec
+	voteStakeKeeper *votestakekeeper.Keeper
+	cdc             codec.BinaryCodec
 }
 
-func NewGovVoteDecorator(cdc codec.BinaryCodec, stakingKeeper *stakingkeeper.Keeper) GovVoteDecorator {
+func NewGovVoteDecorator(cdc codec.BinaryCodec, voteStakeKeeper *votestakekeeper.Keeper) GovVoteDecorator {
 	return GovVoteDecorator{
-		stakingKeeper: stakingKeeper,
-		cdc:           cdc,
+		voteStakeKeeper: voteStakeKeeper,
+		cdc:             cdc,
 	}
 }
 
@@ -77,38 +76,18 @@ func (g GovVoteDecorator) ValidateVoteMsgs(ctx sdk.Context, msgs []sdk.Msg) erro
 			return nil
 		}
 
-		if minStakedTokens.IsZero() {
-			return nil
+		params := g.voteStakeKeeper.GetParams(ctx)
+		if minStakedTokensOverride != nil {
+			params.MinStakedTokens = *minStakedTokensOverride
 		}
 
-		enoughStake := false
-		delegationCount := 0
-		stakedTokens := math.LegacyNewDec(0)
-		err = g.stakingKeeper.IterateDelegatorDelegations(ctx, accAddr, func(delegation stakingtypes.Delegation) bool {
-			validatorAddr, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress)
-			if err != nil {
-				panic(err) // shouldn't happen
-			}
-			validator, err := g.stakingKeeper.GetValidator(ctx, validatorAddr)
-			if err == nil {
-				shares := delegation.Shares
-				tokens := validator.TokensFromSharesTruncated(shares)
-				stakedTokens = stakedTokens.Add(tokens)
-				if stakedTokens.GTE(minStakedTokens) {
-					enoughStake = true
-					return true // break the iteration
-				}
-			}
-			delegationCount++
-			// break the iteration if maxDelegationsChecked were already checked
-			return delegationCount >= maxDelegationsChecked
-		})
+		enoughStake, _, err := g.voteStakeKeeper.CanVote(ctx, accAddr, params)
 		if err != nil {
 			return err
 		}
 
 		if !enoughStake {
-			return errorsmod.Wrapf(gaiaerrors.ErrInsufficientStake, "insufficient stake for voting - min required %v", minStakedTokens)
+			return errorsmod.Wrapf(gaiaerrors.ErrInsufficientStake, "insufficient stake for voting - min required %v", params.MinStakedTokens)
 		}
 
 		return nil
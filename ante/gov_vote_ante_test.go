@@ -13,10 +13,12 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/maany-xyz/maany-provider/ante"
 	"github.com/maany-xyz/maany-provider/app/helpers"
+	votestaketypes "github.com/maany-xyz/maany-provider/x/votestake/types"
 )
 
 // Test that the GovVoteDecorator rejects v1beta1 vote messages from accounts with less than 1 atom staked
@@ -24,7 +26,7 @@ import (
 func TestVoteSpamDecoratorGovV1Beta1(t *testing.T) {
 	gaiaApp := helpers.Setup(t)
 	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
-	decorator := ante.NewGovVoteDecorator(gaiaApp.AppCodec(), gaiaApp.StakingKeeper)
+	decorator := ante.NewGovVoteDecorator(gaiaApp.AppCodec(), &gaiaApp.VoteStakeKeeper)
 	stakingKeeper := gaiaApp.StakingKeeper
 
 	// Get validator
@@ -150,7 +152,7 @@ func TestVoteSpamDecoratorGovV1Beta1(t *testing.T) {
 func TestVoteSpamDecoratorGovV1(t *testing.T) {
 	gaiaApp := helpers.Setup(t)
 	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
-	decorator := ante.NewGovVoteDecorator(gaiaApp.AppCodec(), gaiaApp.StakingKeeper)
+	decorator := ante.NewGovVoteDecorator(gaiaApp.AppCodec(), &gaiaApp.VoteStakeKeeper)
 	stakingKeeper := gaiaApp.StakingKeeper
 
 	// Get validator
@@ -271,3 +273,99 @@ func TestVoteSpamDecoratorGovV1(t *testing.T) {
 		}
 	}
 }
+
+// Test that the GovVoteDecorator uses the x/votestake params, including the
+// flags to count LSM share tokens and unbonding delegations
+func TestVoteSpamDecoratorParams(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+	decorator := ante.NewGovVoteDecorator(gaiaApp.AppCodec(), &gaiaApp.VoteStakeKeeper)
+	stakingKeeper := gaiaApp.StakingKeeper
+
+	validators, err := stakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	valAddr, err := sdk.ValAddressFromBech32(validators[0].GetOperator())
+	require.NoError(t, err)
+	bondDenom, err := stakingKeeper.BondDenom(ctx)
+	require.NoError(t, err)
+
+	addr, err := gaiaApp.AccountKeeper.Accounts.Indexes.Number.MatchExact(ctx, 0)
+	require.NoError(t, err)
+	delegator := sdk.AccAddress(addr)
+
+	// start without any delegation
+	delegations, err := stakingKeeper.GetAllDelegatorDelegations(ctx, delegator)
+	require.NoError(t, err)
+	for _, del := range delegations {
+		valAddr, err := sdk.ValAddressFromBech32(del.GetValidatorAddr())
+		require.NoError(t, err)
+		_, _, err = stakingKeeper.Undelegate(ctx, delegator, valAddr, del.GetShares())
+		require.NoError(t, err)
+	}
+
+	vote := []sdk.Msg{govv1.NewMsgVote(delegator, 0, govv1.VoteOption_VOTE_OPTION_YES, "")}
+	setParams := func(update func(*votestaketypes.Params)) {
+		params := votestaketypes.DefaultParams()
+		params.MinStakedTokens = math.LegacyNewDec(500000)
+		update(&params)
+		require.NoError(t, gaiaApp.VoteStakeKeeper.SetParams(ctx, params))
+	}
+	setParams(func(*votestaketypes.Params) {})
+
+	// the lowered threshold is enough to vote with 0.6 atom
+	val, err := stakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	_, err = stakingKeeper.Delegate(ctx, delegator, math.NewInt(600000), stakingtypes.Unbonded, val, true)
+	require.NoError(t, err)
+	require.NoError(t, decorator.ValidateVoteMsgs(ctx, vote))
+
+	// the delegation cap no longer bounds the delegations counted
+	setParams(func(p *votestaketypes.Params) {
+		p.MinStakedTokens = math.LegacyNewDec(800000)
+		p.MaxDelegationsChecked = 1
+	})
+	pk := ed25519.GenPrivKeyFromSecret([]byte{uint8(14)}).PubKey()
+	validator2, err := stakingtypes.NewValidator(sdk.ValAddress(pk.Address()).String(), pk, stakingtypes.Description{})
+	require.NoError(t, err)
+	validator2.Status = stakingtypes.Bonded
+	require.NoError(t, stakingKeeper.SetValidator(ctx, validator2))
+	require.NoError(t, stakingKeeper.SetValidatorByConsAddr(ctx, validator2))
+	require.NoError(t, stakingKeeper.Hooks().AfterValidatorCreated(ctx, sdk.ValAddress(pk.Address())))
+	require.Error(t, decorator.ValidateVoteMsgs(ctx, vote))
+	shares, err := stakingKeeper.Delegate(ctx, delegator, math.NewInt(300000), stakingtypes.Unbonded, validator2, true)
+	require.NoError(t, err)
+	require.NoError(t, decorator.ValidateVoteMsgs(ctx, vote))
+	_, _, err = stakingKeeper.Undelegate(ctx, delegator, sdk.ValAddress(pk.Address()), shares)
+	require.NoError(t, err)
+	setParams(func(*votestaketypes.Params) {})
+
+	// tokenized shares are only counted behind the flag
+	_, err = stakingkeeper.NewMsgServerImpl(stakingKeeper).TokenizeShares(ctx, &stakingtypes.MsgTokenizeShares{
+		DelegatorAddress:    delegator.String(),
+		ValidatorAddress:    valAddr.String(),
+		Amount:              sdk.NewCoin(bondDenom, math.NewInt(600000)),
+		TokenizedShareOwner: delegator.String(),
+	})
+	require.NoError(t, err)
+	require.Error(t, decorator.ValidateVoteMsgs(ctx, vote))
+	setParams(func(p *votestaketypes.Params) { p.CountLiquidStaked = true })
+	require.NoError(t, decorator.ValidateVoteMsgs(ctx, vote))
+
+	// unbonding delegations are only counted behind the flag
+	setParams(func(*votestaketypes.Params) {})
+	_, err = stakingKeeper.Delegate(ctx, delegator, math.NewInt(600000), stakingtypes.Unbonded, val, true)
+	require.NoError(t, err)
+	del, err := stakingKeeper.GetDelegation(ctx, delegator, valAddr)
+	require.NoError(t, err)
+	_, _, err = stakingKeeper.Undelegate(ctx, delegator, valAddr, del.GetShares())
+	require.NoError(t, err)
+	require.Error(t, decorator.ValidateVoteMsgs(ctx, vote))
+	setParams(func(p *votestaketypes.Params) { p.CountUnbonding = true })
+	require.NoError(t, decorator.ValidateVoteMsgs(ctx, vote))
+
+	// zero disables the check
+	setParams(func(p *votestaketypes.Params) { p.MinStakedTokens = math.LegacyZeroDec() })
+	require.NoError(t, decorator.ValidateVoteMsgs(ctx, []sdk.Msg{
+		govv1.NewMsgVote(sdk.AccAddress("no stake"), 0, govv1.VoteOption_VOTE_OPTION_YES, ""),
+	}))
+}
@@ -0,0 +1,59 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gaiaerrors "github.com/maany-xyz/maany-provider/types/errors"
+	metaprotocolskeeper "github.com/maany-xyz/maany-provider/x/metaprotocols/keeper"
+	"github.com/maany-xyz/maany-provider/x/metaprotocols/types"
+)
+
+// MetaprotocolsDecorator enforces the metaprotocols registry on the
+// ExtensionData attached to txs: registered protocols are checked against
+// their size limit and schema and charged their extra gas, unregistered ones
+// are rejected or size limited depending on the module params.
+type MetaprotocolsDecorator struct {
+	keeper *metaprotocolskeeper.Keeper
+}
+
+func NewMetaprotocolsDecorator(keeper *metaprotocolskeeper.Keeper) MetaprotocolsDecorator {
+	return MetaprotocolsDecorator{
+		keeper: keeper,
+	}
+}
+
+func (m MetaprotocolsDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx,
+	simulate bool, next sdk.AnteHandler,
+) (newCtx sdk.Context, err error) {
+	exts := types.ExtensionDataFromTx(tx)
+	if len(exts) == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	params := m.keeper.GetParams(ctx)
+	for _, ext := range exts {
+		protocol, found := m.keeper.GetProtocol(ctx, ext.ProtocolId)
+		if !found {
+			if params.RejectUnregistered {
+				return ctx, errorsmod.Wrapf(gaiaerrors.ErrInvalidExtensionData, "protocol %q is not registered", ext.ProtocolId)
+			}
+			if params.UnregisteredMaxDataSize > 0 && uint64(len(ext.Data)) > params.UnregisteredMaxDataSize {
+				return ctx, errorsmod.Wrapf(gaiaerrors.ErrInvalidExtensionData,
+					"data of unregistered protocol %q is %d bytes, max is %d", ext.ProtocolId, len(ext.Data), params.UnregisteredMaxDataSize)
+			}
+			continue
+		}
+
+		if err := protocol.ValidateData(ext.Data); err != nil {
+			return ctx, errorsmod.Wrap(gaiaerrors.ErrInvalidExtensionData, err.Error())
+		}
+		if protocol.ExtraGas > 0 {
+			ctx.GasMeter().ConsumeGas(protocol.ExtraGas, "metaprotocols extension data")
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
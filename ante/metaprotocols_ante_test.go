@@ -0,0 +1,113 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/maany-xyz/maany-provider/ante"
+	"github.com/maany-xyz/maany-provider/app/helpers"
+	metaprotocolstypes "github.com/maany-xyz/maany-provider/x/metaprotocols/types"
+)
+
+func TestMetaprotocolsDecorator(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+	k := gaiaApp.MetaprotocolsKeeper
+	decorator := ante.NewMetaprotocolsDecorator(&k)
+
+	require.NoError(t, k.SetProtocol(ctx, metaprotocolstypes.RegisteredProtocol{
+		ProtocolId:  "dex",
+		MaxDataSize: 32,
+		SchemaType:  metaprotocolstypes.SCHEMA_TYPE_JSON,
+		SchemaRef:   "https://example.com/dex-order.schema.json",
+		ExtraGas:    5000,
+	}))
+	require.NoError(t, k.SetProtocol(ctx, metaprotocolstypes.RegisteredProtocol{
+		ProtocolId:  "send",
+		MaxDataSize: 1024,
+		SchemaType:  metaprotocolstypes.SCHEMA_TYPE_PROTO,
+		SchemaRef:   "cosmos.bank.v1beta1.MsgSend",
+	}))
+
+	msgSend, err := (&banktypes.MsgSend{FromAddress: "a", ToAddress: "b"}).Marshal()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name               string
+		ext                *metaprotocolstypes.ExtensionData
+		rejectUnregistered bool
+		expectErr          bool
+		expectGas          uint64
+	}{
+		{
+			name:      "registered - valid json",
+			ext:       &metaprotocolstypes.ExtensionData{ProtocolId: "dex", ProtocolVersion: "1", Data: []byte(`{"side":"buy"}`)},
+			expectGas: 5000,
+		},
+		{
+			name:      "registered - invalid json",
+			ext:       &metaprotocolstypes.ExtensionData{ProtocolId: "dex", ProtocolVersion: "1", Data: []byte(`{"side":`)},
+			expectErr: true,
+		},
+		{
+			name:      "registered - data too large",
+			ext:       &metaprotocolstypes.ExtensionData{ProtocolId: "dex", ProtocolVersion: "1", Data: []byte(`{"side":"buy","amount":"100000000000"}`)},
+			expectErr: true,
+		},
+		{
+			name: "registered - valid proto",
+			ext:  &metaprotocolstypes.ExtensionData{ProtocolId: "send", ProtocolVersion: "1", Data: msgSend},
+		},
+		{
+			name:      "registered - invalid proto",
+			ext:       &metaprotocolstypes.ExtensionData{ProtocolId: "send", ProtocolVersion: "1", Data: []byte{0xff, 0xff}},
+			expectErr: true,
+		},
+		{
+			name: "unregistered - accepted",
+			ext:  &metaprotocolstypes.ExtensionData{ProtocolId: "other", ProtocolVersion: "1", Data: []byte("x")},
+		},
+		{
+			name:               "unregistered - rejected",
+			ext:                &metaprotocolstypes.ExtensionData{ProtocolId: "other", ProtocolVersion: "1", Data: []byte("x")},
+			rejectUnregistered: true,
+			expectErr:          true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := metaprotocolstypes.DefaultParams()
+			params.RejectUnregistered = tc.rejectUnregistered
+			require.NoError(t, k.SetParams(ctx, params))
+
+			builder := gaiaApp.GetTxConfig().NewTxBuilder()
+			extBuilder, ok := builder.(tx.ExtensionOptionsTxBuilder)
+			require.True(t, ok)
+			opt, err := codectypes.NewAnyWithValue(tc.ext)
+			require.NoError(t, err)
+			extBuilder.SetNonCriticalExtensionOptions(opt)
+
+			// only count the extra gas, not the gas of reading the registry
+			gasCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).WithKVGasConfig(storetypes.GasConfig{})
+			_, err = decorator.AnteHandle(gasCtx, builder.GetTx(), false,
+				func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil })
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectGas, gasCtx.GasMeter().GasConsumed())
+		})
+	}
+}
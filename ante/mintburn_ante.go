@@ -0,0 +1,88 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	gaiaerrors "github.com/maany-xyz/maany-provider/types/errors"
+	mintburnkeeper "github.com/maany-xyz/maany-provider/x/mintburn/keeper"
+	mintburntypes "github.com/maany-xyz/maany-provider/x/mintburn/types"
+)
+
+// MintburnDecorator applies the x/mintburn escrow policy: escrows below the
+// minimum amount are rejected and the number of escrows a sender creates per
+// window is limited.
+type MintburnDecorator struct {
+	cdc    codec.BinaryCodec
+	keeper *mintburnkeeper.Keeper
+}
+
+func NewMintburnDecorator(cdc codec.BinaryCodec, keeper *mintburnkeeper.Keeper) MintburnDecorator {
+	return MintburnDecorator{
+		cdc:    cdc,
+		keeper: keeper,
+	}
+}
+
+func (m MintburnDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx,
+	simulate bool, next sdk.AnteHandler,
+) (newCtx sdk.Context, err error) {
+	var params *mintburntypes.Params
+	getParams := func() mintburntypes.Params {
+		if params == nil {
+			p := m.keeper.GetParams(ctx)
+			params = &p
+		}
+		return *params
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		if err := m.validateMsg(ctx, getParams, msg); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// validateMsg applies the escrow policy to msg and to the msgs it wraps in an authz MsgExec.
+func (m MintburnDecorator) validateMsg(ctx sdk.Context, getParams func() mintburntypes.Params, msg sdk.Msg) error {
+	switch msg := msg.(type) {
+	case *mintburntypes.MsgEscrowInitial:
+		// msgs wrapped in an authz MsgExec have not been validated yet, and
+		// MinEscrow can't build a coin of an invalid denom
+		if err := msg.Amount.Validate(); err != nil {
+			return errorsmod.Wrap(gaiaerrors.ErrInvalidCoins, err.Error())
+		}
+		params := getParams()
+		if minEscrow := params.MinEscrow(msg.Amount.Denom); msg.Amount.IsLT(minEscrow) {
+			return errorsmod.Wrapf(gaiaerrors.ErrInvalidCoins, "escrow of %s is below the minimum of %s", msg.Amount, minEscrow)
+		}
+		if params.EscrowRateLimitWindow == 0 {
+			return nil
+		}
+		sender, err := sdk.AccAddressFromBech32(msg.Sender)
+		if err != nil {
+			return err
+		}
+		if count := m.keeper.IncrementEscrowCount(ctx, sender, params.EscrowRateLimitWindow); count > params.MaxEscrowsPerWindow {
+			return errorsmod.Wrapf(gaiaerrors.ErrEscrowRateLimited,
+				"%s already created %d escrows in the last %d blocks", msg.Sender, params.MaxEscrowsPerWindow, params.EscrowRateLimitWindow)
+		}
+	case *authz.MsgExec:
+		for _, anyMsg := range msg.Msgs {
+			var innerMsg sdk.Msg
+			if err := m.cdc.UnpackAny(anyMsg, &innerMsg); err != nil {
+				return errorsmod.Wrap(gaiaerrors.ErrUnauthorized, "cannot unmarshal authz exec msgs")
+			}
+			if err := m.validateMsg(ctx, getParams, innerMsg); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/maany-xyz/maany-provider/ante"
+	"github.com/maany-xyz/maany-provider/app/helpers"
+	gaiaerrors "github.com/maany-xyz/maany-provider/types/errors"
+	mintburntypes "github.com/maany-xyz/maany-provider/x/mintburn/types"
+)
+
+func TestMintburnDecoratorEscrowPolicy(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{}).WithBlockHeight(10)
+	decorator := ante.NewMintburnDecorator(gaiaApp.AppCodec(), &gaiaApp.MintBurnKeeper)
+
+	require.NoError(t, gaiaApp.MintBurnKeeper.SetParams(ctx, mintburntypes.Params{
+		MinEscrowAmount:       sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+		EscrowRateLimitWindow: 10,
+		MaxEscrowsPerWindow:   2,
+	}))
+
+	sender := sdk.AccAddress("escrow_sender_______")
+	escrow := func(amount sdk.Coin) *mintburntypes.MsgEscrowInitial {
+		return &mintburntypes.MsgEscrowInitial{
+			Sender:          sender.String(),
+			ConsumerChainId: "consumer-1",
+			Amount:          amount,
+		}
+	}
+	anteHandle := func(ctx sdk.Context, msgs ...sdk.Msg) error {
+		txBuilder := gaiaApp.GetTxConfig().NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msgs...))
+		_, err := decorator.AnteHandle(ctx, txBuilder.GetTx(), false,
+			func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil })
+		return err
+	}
+
+	// below the minimum, not counted against the rate limit
+	require.Error(t, anteHandle(ctx, escrow(sdk.NewInt64Coin("stake", 999))))
+
+	// denoms without a minimum are accepted
+	require.NoError(t, anteHandle(ctx, escrow(sdk.NewInt64Coin("other", 1))))
+	require.NoError(t, anteHandle(ctx, escrow(sdk.NewInt64Coin("stake", 1000))))
+
+	// the third escrow in the window is rate limited
+	require.Error(t, anteHandle(ctx, escrow(sdk.NewInt64Coin("stake", 1000))))
+	require.Error(t, anteHandle(ctx.WithBlockHeight(19), escrow(sdk.NewInt64Coin("stake", 1000))))
+
+	// a new window starts
+	require.NoError(t, anteHandle(ctx.WithBlockHeight(20), escrow(sdk.NewInt64Coin("stake", 1000))))
+
+	// an invalid denom inside an authz exec is rejected, it has not been
+	// validated before the ante handler
+	exec := authz.NewMsgExec(sdk.AccAddress("escrow_grantee______"), []sdk.Msg{
+		escrow(sdk.Coin{Denom: "1invalid", Amount: math.NewInt(1000)}),
+	})
+	require.ErrorIs(t, anteHandle(ctx.WithBlockHeight(20), &exec), gaiaerrors.ErrInvalidCoins)
+}
@@ -0,0 +1,43 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	relayerlanekeeper "github.com/maany-xyz/maany-provider/x/relayerlane/keeper"
+	relayerlanetypes "github.com/maany-xyz/maany-provider/x/relayerlane/types"
+)
+
+// RelayerLaneDecorator wraps the fee decorator. Txs of registered relayers
+// that only relay packets on the lane channels are marked as lane txs while
+// the lane gas cap of the block allows: they skip the fee decorator if the
+// lane is fee exempt, and otherwise are checked against the discounted fee
+// market gas price. All other txs pay the full fee.
+//
+// The discount only applies to the fee market prices, the fallback fee
+// decorator used while the fee market is disabled charges lane txs in full
+// unless they are fee exempt.
+type RelayerLaneDecorator struct {
+	keeper       *relayerlanekeeper.Keeper
+	feeDecorator sdk.AnteDecorator
+}
+
+func NewRelayerLaneDecorator(keeper *relayerlanekeeper.Keeper, feeDecorator sdk.AnteDecorator) RelayerLaneDecorator {
+	return RelayerLaneDecorator{
+		keeper:       keeper,
+		feeDecorator: feeDecorator,
+	}
+}
+
+func (r RelayerLaneDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	params := r.keeper.GetParams(ctx)
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || !r.keeper.IsLaneTx(ctx, tx, params) || !r.keeper.ConsumeBlockGas(ctx, feeTx.GetGas(), params.MaxBlockGas) {
+		return r.feeDecorator.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	ctx = relayerlanetypes.WithLaneDiscount(ctx, params.FeeDiscount)
+	if params.IsFeeExempt() {
+		return next(ctx, tx, simulate)
+	}
+	return r.feeDecorator.AnteHandle(ctx, tx, simulate, next)
+}
@@ -0,0 +1,102 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/maany-xyz/maany-provider/ante"
+	"github.com/maany-xyz/maany-provider/app/helpers"
+	relayerlanetypes "github.com/maany-xyz/maany-provider/x/relayerlane/types"
+)
+
+type feeDecoratorMock struct {
+	called bool
+}
+
+func (m *feeDecoratorMock) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	m.called = true
+	return next(ctx, tx, simulate)
+}
+
+func TestRelayerLaneDecorator(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+	k := gaiaApp.RelayerLaneKeeper
+
+	relayer := sdk.AccAddress("relayer_____________")
+	other := sdk.AccAddress("other_______________")
+	params := relayerlanetypes.DefaultParams()
+	params.Relayers = []string{relayer.String()}
+	params.Channels = []string{"channel-0"}
+	params.MaxBlockGas = 250_000
+	require.NoError(t, k.SetParams(ctx, params))
+
+	recv := func(channelID string, signer sdk.AccAddress) sdk.Msg {
+		return &channeltypes.MsgRecvPacket{
+			Packet: channeltypes.Packet{DestinationChannel: channelID},
+			Signer: signer.String(),
+		}
+	}
+	update := &clienttypes.MsgUpdateClient{ClientId: "07-tendermint-0", Signer: relayer.String()}
+	send := banktypes.NewMsgSend(relayer, relayer, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+
+	// anteHandle returns whether the fee decorator ran and the lane discount
+	// the tx was marked with
+	anteHandle := func(gas uint64, msgs ...sdk.Msg) (bool, math.LegacyDec, bool) {
+		fee := &feeDecoratorMock{}
+		decorator := ante.NewRelayerLaneDecorator(&k, fee)
+		txBuilder := gaiaApp.GetTxConfig().NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msgs...))
+		txBuilder.SetGasLimit(gas)
+		newCtx, err := decorator.AnteHandle(ctx, txBuilder.GetTx(), false,
+			func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil })
+		require.NoError(t, err)
+		discount, inLane := relayerlanetypes.LaneDiscount(newCtx)
+		return fee.called, discount, inLane
+	}
+
+	// a fee exempt lane tx skips the fee decorator
+	called, discount, inLane := anteHandle(100_000, update, recv("channel-0", relayer))
+	require.False(t, called)
+	require.True(t, inLane)
+	require.True(t, discount.Equal(math.LegacyOneDec()))
+	require.Equal(t, uint64(100_000), k.GetBlockGas(ctx))
+
+	// txs that aren't relayed by a registered relayer on a lane channel or
+	// carry other msgs pay the full fee
+	for _, msgs := range [][]sdk.Msg{
+		{update},
+		{recv("channel-1", relayer)},
+		{recv("channel-0", other)},
+		{recv("channel-0", relayer), send},
+	} {
+		called, _, inLane = anteHandle(100_000, msgs...)
+		require.True(t, called)
+		require.False(t, inLane)
+	}
+
+	// the block gas cap is reached
+	called, _, inLane = anteHandle(200_000, recv("channel-0", relayer))
+	require.True(t, called)
+	require.False(t, inLane)
+	require.Equal(t, uint64(100_000), k.GetBlockGas(ctx))
+
+	// a discounted lane tx goes through the fee decorator
+	params.FeeDiscount = math.LegacyNewDecWithPrec(8, 1)
+	require.NoError(t, k.SetParams(ctx, params))
+	called, discount, inLane = anteHandle(150_000, recv("channel-0", relayer))
+	require.True(t, called)
+	require.True(t, inLane)
+	require.True(t, discount.Equal(params.FeeDiscount))
+	require.Equal(t, uint64(250_000), k.GetBlockGas(ctx))
+}
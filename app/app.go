@@ -68,13 +68,16 @@ import (
 	"github.com/maany-xyz/maany-provider/app/keepers"
 	"github.com/maany-xyz/maany-provider/app/upgrades"
 	v19 "github.com/maany-xyz/maany-provider/app/upgrades/v19"
+	v20 "github.com/maany-xyz/maany-provider/app/upgrades/v20"
+	metaprotocolsindex "github.com/maany-xyz/maany-provider/x/metaprotocols/index"
+	metaprotocolstypes "github.com/maany-xyz/maany-provider/x/metaprotocols/types"
 )
 
 var (
 	// DefaultNodeHome default home directories for the application daemon
 	DefaultNodeHome string
 
-	Upgrades = []upgrades.Upgrade{v19.Upgrade}
+	Upgrades = []upgrades.Upgrade{v19.Upgrade, v20.Upgrade}
 )
 
 var (
@@ -103,6 +106,9 @@ type GaiaApp struct { //nolint: revive
 	// simulation manager
 	sm           *module.SimulationManager
 	configurator module.Configurator
+
+	// node-local extension data index, nil unless enabled in app.toml
+	metaprotocolsIndexer *metaprotocolsindex.Indexer
 }
 
 func init() {
@@ -195,6 +201,18 @@ func NewGaiaApp(
 		wasmOpts,
 	)
 
+	indexConfig, err := metaprotocolstypes.ReadIndexConfig(appOpts)
+	if err != nil {
+		panic("error while reading metaprotocols config: " + err.Error())
+	}
+	if indexConfig.Enabled {
+		indexDB, err := dbm.NewDB(metaprotocolsindex.DBName, server.GetAppDBBackend(appOpts), filepath.Join(homePath, "data"))
+		if err != nil {
+			panic(fmt.Errorf("failed to open metaprotocols index: %w", err))
+		}
+		app.metaprotocolsIndexer = metaprotocolsindex.NewIndexer(indexDB, indexConfig.RetainBlocks)
+	}
+
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
 	app.mm = module.NewManager(appModules(app, appCodec, txConfig, skipGenesisInvariants)...)
@@ -290,6 +308,12 @@ func NewGaiaApp(
 			StakingKeeper:         app.StakingKeeper,
 			FeeMarketKeeper:       app.FeeMarketKeeper,
 			WasmConfig:            &wasmConfig,
+			MetaprotocolsKeeper:   &app.MetaprotocolsKeeper,
+			ExpeditedKeeper:       &app.ExpeditedKeeper,
+			VoteStakeKeeper:       &app.VoteStakeKeeper,
+			MintburnKeeper:        &app.MintBurnKeeper,
+			CircuitBreakerKeeper:  &app.CircuitBreakerKeeper,
+			RelayerLaneKeeper:     &app.RelayerLaneKeeper,
 			TXCounterStoreService: runtime.NewKVStoreService(app.AppKeepers.GetKey(wasmtypes.StoreKey)),
 			TxFeeChecker: func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
 				return minTxFeesChecker(ctx, tx, *app.FeeMarketKeeper)
@@ -304,6 +328,9 @@ func NewGaiaApp(
 		AccountKeeper:   app.AccountKeeper,
 		BankKeeper:      app.BankKeeper,
 		FeeMarketKeeper: app.FeeMarketKeeper,
+
+		BlockRewardsKeeper:   &app.BlockRewardsKeeper,
+		MetaprotocolsIndexer: app.metaprotocolsIndexer,
 	}
 	postHandler, err := NewPostHandler(postHandlerOptions)
 	if err != nil {
@@ -361,7 +388,10 @@ func NewGaiaApp(
 func (app *GaiaApp) Name() string { return app.BaseApp.Name() }
 
 // PreBlocker application updates every pre block
-func (app *GaiaApp) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+func (app *GaiaApp) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+	// blockrewards sizes usage-based rewards on the number of included txs,
+	// which is only visible from the finalize request.
+	app.BlockRewardsKeeper.SetBlockTxCount(ctx, uint64(len(req.Txs)))
 	return app.mm.PreBlock(ctx)
 }
 
@@ -399,6 +429,17 @@ func (app *GaiaApp) LoadHeight(height int64) error {
 	return app.LoadVersion(height)
 }
 
+// Close closes the app and the metaprotocols index, if it is enabled.
+func (app *GaiaApp) Close() error {
+	if err := app.BaseApp.Close(); err != nil {
+		return err
+	}
+	if app.metaprotocolsIndexer != nil {
+		return app.metaprotocolsIndexer.Close()
+	}
+	return nil
+}
+
 // ModuleAccountAddrs returns all the app's module account addresses.
 func (app *GaiaApp) ModuleAccountAddrs() map[string]bool {
 	modAccAddrs := make(map[string]bool)
@@ -573,7 +614,8 @@ func (ao EmptyAppOptions) Get(_ string) interface{} {
 // minTxFeesChecker will be executed only if the feemarket module is disabled.
 // In this case, the auth module's DeductFeeDecorator is executed, and
 // we use the minTxFeesChecker to enforce the minimum transaction fees.
-// Min tx fees are calculated as gas_limit * feemarket_min_base_gas_price
+// Min tx fees are calculated as gas_limit * feemarket_min_base_gas_price, converted
+// by the feemarket denom resolver when the fee is paid in another denom.
 func minTxFeesChecker(ctx sdk.Context, tx sdk.Tx, feemarketKp feemarketkeeper.Keeper) (sdk.Coins, int64, error) {
 	feeTx, ok := tx.(sdk.FeeTx)
 	if !ok {
@@ -590,10 +632,14 @@ func minTxFeesChecker(ctx sdk.Context, tx sdk.Tx, feemarketKp feemarketkeeper.Ke
 		return nil, 0, err
 	}
 
-	feeRequired := sdk.NewCoins(
-		sdk.NewCoin(
-			feeMarketParams.FeeDenom,
-			feeMarketParams.MinBaseGasPrice.MulInt(math.NewIntFromUint64(feeTx.GetGas())).Ceil().RoundInt()))
+	requiredFee := func(minGasPrice sdk.DecCoin) sdk.Coins {
+		return sdk.NewCoins(
+			sdk.NewCoin(
+				minGasPrice.Denom,
+				minGasPrice.Amount.MulInt(math.NewIntFromUint64(feeTx.GetGas())).Ceil().RoundInt()))
+	}
+	minGasPrice := sdk.NewDecCoinFromDec(feeMarketParams.FeeDenom, feeMarketParams.MinBaseGasPrice)
+	feeRequired := requiredFee(minGasPrice)
 
 	feeCoins := feeTx.GetFee()
 	if len(feeCoins) != 1 {
@@ -601,6 +647,14 @@ func minTxFeesChecker(ctx sdk.Context, tx sdk.Tx, feemarketKp feemarketkeeper.Ke
 			"expected exactly one fee coin; got %s, required: %s", feeCoins.String(), feeRequired.String())
 	}
 
+	if feeDenom := feeCoins[0].Denom; feeDenom != minGasPrice.Denom {
+		minGasPrice, err = feemarketKp.ResolveToDenom(ctx, minGasPrice, feeDenom)
+		if err != nil {
+			return nil, 0, errorsmod.Wrapf(err, "unable to get min gas price for denom %s", feeDenom)
+		}
+		feeRequired = requiredFee(minGasPrice)
+	}
+
 	if !feeCoins.IsAnyGTE(feeRequired) {
 		return nil, 0, fmt.Errorf(
 			"not enough fees provided; got %s, required: %s", feeCoins.String(), feeRequired.String())
@@ -1,7 +1,6 @@
 package keepers
 
 import (
-	"fmt"
 	"os"
 
 	feemarketkeeper "github.com/skip-mev/feemarket/x/feemarket/keeper"
@@ -86,9 +85,21 @@ import (
 	blockrewardskeeper "github.com/maany-xyz/maany-provider/x/blockrewards/keeper"
 	blockrewardsmoduletypes "github.com/maany-xyz/maany-provider/x/blockrewards/types"
 
+	circuitbreakerkeeper "github.com/maany-xyz/maany-provider/x/circuitbreaker/keeper"
+	circuitbreakertypes "github.com/maany-xyz/maany-provider/x/circuitbreaker/types"
+	expeditedkeeper "github.com/maany-xyz/maany-provider/x/expedited/keeper"
+	feepriceskeeper "github.com/maany-xyz/maany-provider/x/feeprices/keeper"
+	feepricestypes "github.com/maany-xyz/maany-provider/x/feeprices/types"
+	relayerlanekeeper "github.com/maany-xyz/maany-provider/x/relayerlane/keeper"
+	relayerlanetypes "github.com/maany-xyz/maany-provider/x/relayerlane/types"
+	expeditedtypes "github.com/maany-xyz/maany-provider/x/expedited/types"
+	metaprotocolskeeper "github.com/maany-xyz/maany-provider/x/metaprotocols/keeper"
+	metaprotocolstypes "github.com/maany-xyz/maany-provider/x/metaprotocols/types"
 	mintburn "github.com/maany-xyz/maany-provider/x/mintburn/keeper"
 	mintburnmodule "github.com/maany-xyz/maany-provider/x/mintburn/module"
 	mintburntypes "github.com/maany-xyz/maany-provider/x/mintburn/types"
+	votestakekeeper "github.com/maany-xyz/maany-provider/x/votestake/keeper"
+	votestaketypes "github.com/maany-xyz/maany-provider/x/votestake/types"
 )
 
 type AppKeepers struct {
@@ -121,6 +132,12 @@ type AppKeepers struct {
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
 	FeeMarketKeeper       *feemarketkeeper.Keeper
 	BlockRewardsKeeper 	 blockrewardskeeper.Keeper
+	MetaprotocolsKeeper   metaprotocolskeeper.Keeper
+	ExpeditedKeeper       expeditedkeeper.Keeper
+	VoteStakeKeeper       votestakekeeper.Keeper
+	CircuitBreakerKeeper  circuitbreakerkeeper.Keeper
+	FeePricesKeeper       feepriceskeeper.Keeper
+	RelayerLaneKeeper     relayerlanekeeper.Keeper
 
 	// ICS
 	ProviderKeeper icsproviderkeeper.Keeper
@@ -248,11 +265,13 @@ func NewAppKeeper(
 		appKeepers.AccountKeeper,
 	)
 
+	// the bank keeper lets the mintburn ante decorator grant allowances to
+	// accounts that do not exist yet
 	appKeepers.FeeGrantKeeper = feegrantkeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(appKeepers.keys[feegrant.StoreKey]),
 		appKeepers.AccountKeeper,
-	)
+	).SetBankKeeper(appKeepers.BankKeeper)
 
 	appKeepers.StakingKeeper = stakingkeeper.NewKeeper(
 		appCodec,
@@ -299,6 +318,8 @@ func NewAppKeeper(
 			appKeepers.DistrKeeper.Hooks(),
 			appKeepers.SlashingKeeper.Hooks(),
 			appKeepers.ProviderKeeper.Hooks(),
+			appKeepers.BlockRewardsKeeper.Hooks(),
+			appKeepers.VoteStakeKeeper.Hooks(),
 		),
 	)
 
@@ -306,7 +327,7 @@ func NewAppKeeper(
 		appCodec,
 		appKeepers.keys[feemarkettypes.StoreKey],
 		appKeepers.AccountKeeper,
-		&DefaultFeemarketDenomResolver{},
+		nil, // the feeprices keeper becomes the denom resolver once the wasm keeper exists
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
@@ -314,9 +335,41 @@ func NewAppKeeper(
 	appKeepers.BlockRewardsKeeper = blockrewardskeeper.NewKeeper(
 		appCodec,
 		appKeepers.keys[blockrewardsmoduletypes.StoreKey],
+		appKeepers.tkeys[blockrewardsmoduletypes.TStoreKey],
     	appKeepers.BankKeeper,
         *appKeepers.StakingKeeper,
     	appKeepers.AccountKeeper,
+		appKeepers.SlashingKeeper,
+		appKeepers.DistrKeeper,
+		// the provider keeper is created below, pass it by reference
+		&appKeepers.ProviderKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	appKeepers.MetaprotocolsKeeper = metaprotocolskeeper.NewKeeper(
+		appCodec,
+		appKeepers.keys[metaprotocolstypes.StoreKey],
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	appKeepers.ExpeditedKeeper = expeditedkeeper.NewKeeper(
+		appCodec,
+		appKeepers.keys[expeditedtypes.StoreKey],
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	appKeepers.VoteStakeKeeper = votestakekeeper.NewKeeper(
+		appCodec,
+		appKeepers.keys[votestaketypes.StoreKey],
+		appKeepers.StakingKeeper,
+		appKeepers.BankKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	appKeepers.CircuitBreakerKeeper = circuitbreakerkeeper.NewKeeper(
+		appCodec,
+		appKeepers.keys[circuitbreakertypes.StoreKey],
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	// UpgradeKeeper must be created before IBCKeeper
@@ -387,6 +440,8 @@ func NewAppKeeper(
 		appKeepers.IBCKeeper.ChannelKeeper,
 		appKeepers.IBCKeeper.ConnectionKeeper, 
 		appKeepers.IBCKeeper.ClientKeeper,
+		appKeepers.CircuitBreakerKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	// Register the proposal types
 	// Deprecated: Avoid adding new handlers, instead use the new proposal flow
@@ -523,6 +578,24 @@ func NewAppKeeper(
 		wasmOpts...,
 	)
 
+	// fees may be paid in the denoms of the feeprices price table, whose rates
+	// can be fed by a wasm oracle contract
+	appKeepers.FeePricesKeeper = feepriceskeeper.NewKeeper(
+		appCodec,
+		appKeepers.keys[feepricestypes.StoreKey],
+		appKeepers.WasmKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	appKeepers.FeeMarketKeeper.SetDenomResolver(appKeepers.FeePricesKeeper)
+
+	// registered relayers relay packets on the lane channels at a discount
+	appKeepers.RelayerLaneKeeper = relayerlanekeeper.NewKeeper(
+		appCodec,
+		appKeepers.keys[relayerlanetypes.StoreKey],
+		appKeepers.tkeys[relayerlanetypes.TStoreKey],
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	// Middleware Stacks
 	appKeepers.ICAModule = ica.NewAppModule(&appKeepers.ICAControllerKeeper, &appKeepers.ICAHostKeeper)
 	appKeepers.TransferModule = transfer.NewAppModule(appKeepers.TransferKeeper)
@@ -617,17 +690,3 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 
 	return paramsKeeper
 }
-
-type DefaultFeemarketDenomResolver struct{}
-
-func (r *DefaultFeemarketDenomResolver) ConvertToDenom(_ sdk.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error) {
-	if coin.Denom == denom {
-		return coin, nil
-	}
-
-	return sdk.DecCoin{}, fmt.Errorf("error resolving denom")
-}
-
-func (r *DefaultFeemarketDenomResolver) ExtraDenoms(_ sdk.Context) ([]string, error) {
-	return []string{}, nil
-}
@@ -33,7 +33,13 @@ import (
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	blockrewardsmoduletypes "github.com/maany-xyz/maany-provider/x/blockrewards/types"
 
+	circuitbreakertypes "github.com/maany-xyz/maany-provider/x/circuitbreaker/types"
+	expeditedtypes "github.com/maany-xyz/maany-provider/x/expedited/types"
+	feepricestypes "github.com/maany-xyz/maany-provider/x/feeprices/types"
+	relayerlanetypes "github.com/maany-xyz/maany-provider/x/relayerlane/types"
+	metaprotocolstypes "github.com/maany-xyz/maany-provider/x/metaprotocols/types"
 	mintburntypes "github.com/maany-xyz/maany-provider/x/mintburn/types"
+	votestaketypes "github.com/maany-xyz/maany-provider/x/votestake/types"
 )
 
 func (appKeepers *AppKeepers) GenerateKeys() {
@@ -67,10 +73,16 @@ func (appKeepers *AppKeepers) GenerateKeys() {
 		wasmtypes.StoreKey,
 		blockrewardsmoduletypes.StoreKey,
 		mintburntypes.StoreKey,
+		metaprotocolstypes.StoreKey,
+		expeditedtypes.StoreKey,
+		votestaketypes.StoreKey,
+		circuitbreakertypes.StoreKey,
+		feepricestypes.StoreKey,
+		relayerlanetypes.StoreKey,
 	)
 
 	// Define transient store keys
-	appKeepers.tkeys = storetypes.NewTransientStoreKeys(paramstypes.TStoreKey)
+	appKeepers.tkeys = storetypes.NewTransientStoreKeys(paramstypes.TStoreKey, blockrewardsmoduletypes.TStoreKey, relayerlanetypes.TStoreKey)
 
 	// MemKeys are for information that is stored only in RAM.
 	appKeepers.memKeys = storetypes.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -61,8 +61,18 @@ import (
 	wasm "github.com/CosmWasm/wasmd/x/wasm"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 
+	"github.com/maany-xyz/maany-provider/x/circuitbreaker"
+	circuitbreakertypes "github.com/maany-xyz/maany-provider/x/circuitbreaker/types"
+	"github.com/maany-xyz/maany-provider/x/expedited"
+	"github.com/maany-xyz/maany-provider/x/feeprices"
+	feepricestypes "github.com/maany-xyz/maany-provider/x/feeprices/types"
+	"github.com/maany-xyz/maany-provider/x/relayerlane"
+	relayerlanetypes "github.com/maany-xyz/maany-provider/x/relayerlane/types"
+	expeditedtypes "github.com/maany-xyz/maany-provider/x/expedited/types"
 	"github.com/maany-xyz/maany-provider/x/metaprotocols"
 	metaprotocolstypes "github.com/maany-xyz/maany-provider/x/metaprotocols/types"
+	"github.com/maany-xyz/maany-provider/x/votestake"
+	votestaketypes "github.com/maany-xyz/maany-provider/x/votestake/types"
 
 	blockrewardsmodule "github.com/maany-xyz/maany-provider/x/blockrewards"
 	blockrewardsmoduletypes "github.com/maany-xyz/maany-provider/x/blockrewards/types"
@@ -86,7 +96,7 @@ var maccPerms = map[string][]string{
 	wasmtypes.ModuleName:               {authtypes.Burner},
 	feemarkettypes.ModuleName:          nil,
 	feemarkettypes.FeeCollectorName:    nil,
-	blockrewardsmoduletypes.ModuleName: {authtypes.Minter},
+	blockrewardsmoduletypes.ModuleName: {authtypes.Minter, authtypes.Burner},
 	mintburntypes.ModuleName:           {authtypes.Minter, authtypes.Burner},
 
 
@@ -130,7 +140,12 @@ func appModules(
 		app.PFMRouterModule,
 		app.RateLimitModule,
 		app.ProviderModule,
-		metaprotocols.NewAppModule(),
+		metaprotocols.NewAppModule(app.MetaprotocolsKeeper, app.metaprotocolsIndexer),
+		expedited.NewAppModule(app.ExpeditedKeeper),
+		votestake.NewAppModule(app.VoteStakeKeeper),
+		circuitbreaker.NewAppModule(app.CircuitBreakerKeeper),
+		feeprices.NewAppModule(app.FeePricesKeeper),
+		relayerlane.NewAppModule(app.RelayerLaneKeeper),
 		feemarket.NewAppModule(appCodec, *app.FeeMarketKeeper),
 		blockrewardsmodule.NewAppModule(appCodec, app.BlockRewardsKeeper),
 		mintburnmodule.NewAppModule(appCodec, app.MintBurnKeeper, app.Logger()),
@@ -227,6 +242,11 @@ func orderBeginBlockers() []string {
 		providertypes.ModuleName,
 		consensusparamtypes.ModuleName,
 		metaprotocolstypes.ModuleName,
+		expeditedtypes.ModuleName,
+		votestaketypes.ModuleName,
+		circuitbreakertypes.ModuleName,
+		feepricestypes.ModuleName,
+		relayerlanetypes.ModuleName,
 		wasmtypes.ModuleName,
 	}
 }
@@ -267,6 +287,11 @@ func orderEndBlockers() []string {
 		providertypes.ModuleName,
 		consensusparamtypes.ModuleName,
 		metaprotocolstypes.ModuleName,
+		expeditedtypes.ModuleName,
+		votestaketypes.ModuleName,
+		circuitbreakertypes.ModuleName,
+		feepricestypes.ModuleName,
+		relayerlanetypes.ModuleName,
 		wasmtypes.ModuleName,
 		blockrewardsmoduletypes.ModuleName,
 	}
@@ -315,6 +340,11 @@ func orderInitBlockers() []string {
 		providertypes.ModuleName,
 		consensusparamtypes.ModuleName,
 		metaprotocolstypes.ModuleName,
+		expeditedtypes.ModuleName,
+		votestaketypes.ModuleName,
+		circuitbreakertypes.ModuleName,
+		feepricestypes.ModuleName,
+		relayerlanetypes.ModuleName,
 		wasmtypes.ModuleName,
 		blockrewardsmoduletypes.ModuleName,
 		mintburntypes.ModuleName,
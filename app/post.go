@@ -9,6 +9,11 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/maany-xyz/maany-provider/ante"
+	blockrewardskeeper "github.com/maany-xyz/maany-provider/x/blockrewards/keeper"
+	blockrewardspost "github.com/maany-xyz/maany-provider/x/blockrewards/post"
+	metaprotocolsindex "github.com/maany-xyz/maany-provider/x/metaprotocols/index"
+	relayerlanekeeper "github.com/maany-xyz/maany-provider/x/relayerlane/keeper"
+	relayerlanepost "github.com/maany-xyz/maany-provider/x/relayerlane/post"
 )
 
 // PostHandlerOptions are the options required for constructing a FeeMarket PostHandler.
@@ -16,12 +21,27 @@ type PostHandlerOptions struct {
 	AccountKeeper   feemarketpost.AccountKeeper
 	BankKeeper      feemarketpost.BankKeeper
 	FeeMarketKeeper feemarketpost.FeeMarketKeeper
+
+	// BlockRewardsKeeper routes a share of the deducted fees to blockrewards
+	BlockRewardsKeeper *blockrewardskeeper.Keeper
+
+	// MetaprotocolsIndexer is optional, txs are only indexed if it is set
+	MetaprotocolsIndexer *metaprotocolsindex.Indexer
 }
 
-// NewPostHandler returns a PostHandler chain with the fee deduct decorator.
+// NewPostHandler returns a PostHandler chain with the fee share and fee deduct
+// decorators and, if indexing is enabled, the metaprotocols index decorator.
 func NewPostHandler(options PostHandlerOptions) (sdk.PostHandler, error) {
+	var postDecorators []sdk.PostDecorator
+	if options.MetaprotocolsIndexer != nil {
+		postDecorators = append(postDecorators, metaprotocolsindex.NewIndexDecorator(options.MetaprotocolsIndexer))
+	}
+
 	if !ante.UseFeeMarketDecorator {
-		return nil, nil
+		if len(postDecorators) == 0 {
+			return nil, nil
+		}
+		return sdk.ChainPostDecorators(postDecorators...), nil
 	}
 
 	if options.AccountKeeper == nil {
@@ -36,13 +56,24 @@ func NewPostHandler(options PostHandlerOptions) (sdk.PostHandler, error) {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "feemarket keeper is required for post builder")
 	}
 
-	postDecorators := []sdk.PostDecorator{
-		feemarketpost.NewFeeMarketDeductDecorator(
-			options.AccountKeeper,
-			options.BankKeeper,
-			options.FeeMarketKeeper,
-		),
+	if options.BlockRewardsKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "blockrewards keeper is required for post builder")
 	}
 
+	postDecorators = append(postDecorators,
+		// lane txs pay the discounted fee they were checked against, or
+		// nothing if they are fee exempt
+		relayerlanepost.NewFeeExemptDecorator(
+			blockrewardspost.NewFeeShareDecorator(options.BlockRewardsKeeper, options.FeeMarketKeeper),
+		),
+		relayerlanepost.NewFeeExemptDecorator(
+			feemarketpost.NewFeeMarketDeductDecorator(
+				options.AccountKeeper,
+				options.BankKeeper,
+				relayerlanekeeper.NewLaneFeeMarketKeeper(options.FeeMarketKeeper),
+			),
+		),
+	)
+
 	return sdk.ChainPostDecorators(postDecorators...), nil
 }
@@ -0,0 +1,33 @@
+package v20
+
+import (
+	store "cosmossdk.io/store/types"
+
+	"github.com/maany-xyz/maany-provider/app/upgrades"
+	circuitbreakertypes "github.com/maany-xyz/maany-provider/x/circuitbreaker/types"
+	expeditedtypes "github.com/maany-xyz/maany-provider/x/expedited/types"
+	feepricestypes "github.com/maany-xyz/maany-provider/x/feeprices/types"
+	metaprotocolstypes "github.com/maany-xyz/maany-provider/x/metaprotocols/types"
+	relayerlanetypes "github.com/maany-xyz/maany-provider/x/relayerlane/types"
+	votestaketypes "github.com/maany-xyz/maany-provider/x/votestake/types"
+)
+
+const (
+	// UpgradeName defines the on-chain upgrade name.
+	UpgradeName = "v20"
+)
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades: store.StoreUpgrades{
+		Added: []string{
+			metaprotocolstypes.StoreKey,
+			expeditedtypes.StoreKey,
+			votestaketypes.StoreKey,
+			circuitbreakertypes.StoreKey,
+			feepricestypes.StoreKey,
+			relayerlanetypes.StoreKey,
+		},
+	},
+}
@@ -0,0 +1,63 @@
+package v20
+
+import (
+	"context"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/maany-xyz/maany-provider/app/keepers"
+	circuitbreakertypes "github.com/maany-xyz/maany-provider/x/circuitbreaker/types"
+	expeditedtypes "github.com/maany-xyz/maany-provider/x/expedited/types"
+	feepricestypes "github.com/maany-xyz/maany-provider/x/feeprices/types"
+	metaprotocolstypes "github.com/maany-xyz/maany-provider/x/metaprotocols/types"
+	relayerlanetypes "github.com/maany-xyz/maany-provider/x/relayerlane/types"
+	votestaketypes "github.com/maany-xyz/maany-provider/x/votestake/types"
+)
+
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	keepers *keepers.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(c context.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		ctx := sdk.UnwrapSDKContext(c)
+		ctx.Logger().Info("Starting module migrations...")
+
+		// the added modules are missing from vm, so their default genesis is run
+		vm, err := mm.RunMigrations(ctx, configurator, vm)
+		if err != nil {
+			return vm, err
+		}
+
+		if err := InitializeParams(ctx, keepers); err != nil {
+			return vm, err
+		}
+
+		ctx.Logger().Info("Upgrade v20 complete")
+		return vm, nil
+	}
+}
+
+// InitializeParams sets the params of the modules added in v20. The defaults
+// keep the behaviour the chain had before they became gov-managed.
+func InitializeParams(ctx sdk.Context, keepers *keepers.AppKeepers) error {
+	if err := keepers.MetaprotocolsKeeper.SetParams(ctx, metaprotocolstypes.DefaultParams()); err != nil {
+		return err
+	}
+	if err := keepers.ExpeditedKeeper.SetParams(ctx, expeditedtypes.DefaultParams()); err != nil {
+		return err
+	}
+	if err := keepers.VoteStakeKeeper.SetParams(ctx, votestaketypes.DefaultParams()); err != nil {
+		return err
+	}
+	if err := keepers.CircuitBreakerKeeper.SetParams(ctx, circuitbreakertypes.DefaultParams()); err != nil {
+		return err
+	}
+	if err := keepers.FeePricesKeeper.SetParams(ctx, feepricestypes.DefaultParams()); err != nil {
+		return err
+	}
+	return keepers.RelayerLaneKeeper.SetParams(ctx, relayerlanetypes.DefaultParams())
+}
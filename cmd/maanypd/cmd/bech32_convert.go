@@ -1,21 +1,30 @@
 package cmd
 
 import (
+	"encoding/hex"
 	"fmt"
 
 	"github.com/spf13/cobra"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gaia "github.com/maany-xyz/maany-provider/app"
 	addressutil "github.com/maany-xyz/maany-provider/pkg/address"
 )
 
-var flagBech32Prefix = "prefix"
+var (
+	flagBech32Prefix = "prefix"
+	flagICAAppHash   = "app-hash"
+	flagICADataHash  = "data-hash"
+)
 
 // AddBech32ConvertCommand returns bech32-convert cobra Command.
 func AddBech32ConvertCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "bech32-convert [address]",
-		Short: "Convert any bech32 string to the cosmos prefix",
-		Long: `Convert any bech32 string to the cosmos prefix
+		Short: "Convert any bech32 string to the chain prefix, or derive a module, transfer escrow or ICA address",
+		Long: `Convert any bech32 string to the chain prefix, or to --prefix. The subcommands derive
+the address of a module account, of a transfer escrow or of an interchain account.
 
 Example:
 	gaiad debug bech32-convert akash1a6zlyvpnksx8wr6wz8wemur2xe8zyh0ytz6d88
@@ -41,13 +50,102 @@ Example:
 		},
 	}
 
-	cmd.Flags().StringP(flagBech32Prefix, "p", "cosmos", "Bech32 Prefix to encode to")
+	cmd.PersistentFlags().StringP(flagBech32Prefix, "p", sdk.GetConfig().GetBech32AccountAddrPrefix(), "Bech32 Prefix to encode to")
+	cmd.AddCommand(
+		moduleAddressCommand(),
+		transferEscrowAddressCommand(),
+		icaHostAddressCommand(),
+	)
+
+	return cmd
+}
+
+func moduleAddressCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "module [module-name]",
+		Short: "Derive the address of the module account of a module",
+		Example: `	gaiad debug bech32-convert module mintburn
+	gaiad debug bech32-convert module bonded_tokens_pool`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return printDerivedAddress(cmd, addressutil.ModuleAddress(args[0]))
+		},
+	}
+}
+
+func transferEscrowAddressCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "transfer-escrow [port-id] [channel-id]",
+		Short: "Derive the address escrowing the tokens sent over a transfer channel",
+		Long: `Derive the address escrowing the tokens sent over a transfer channel, as ibc-go
+computes it.
+`,
+		Example: `	gaiad debug bech32-convert transfer-escrow transfer channel-1
+	gaiad debug bech32-convert transfer-escrow transfer channel-0 --prefix maany-dex`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return printDerivedAddress(cmd, addressutil.TransferEscrowAddress(args[0], args[1]))
+		},
+	}
+}
+
+func icaHostAddressCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ica-host [connection-id] [controller-port-id]",
+		Short: "Derive the address of an interchain account on the host chain",
+		Long: `Derive the address of the interchain account the host chain creates for a
+controller port, icacontroller-<owner>, on its end of a connection.
+
+ibc-go mixes the app hash and the data hash of the header of the block where the
+host opened the channel into the address. Pass them, hex encoded, with --app-hash
+and --data-hash, as the block query of the host at that height shows them.
+`,
+		Example: `	gaiad debug bech32-convert ica-host connection-0 icacontroller-maany1... \
+		--app-hash 5A1F... --data-hash E3B0... --prefix maany-dex`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var hashes [2][]byte
+			for i, flag := range []string{flagICAAppHash, flagICADataHash} {
+				s, err := cmd.Flags().GetString(flag)
+				if err != nil {
+					return err
+				}
+				if s == "" {
+					return fmt.Errorf("--%s must not be empty", flag)
+				}
+				if hashes[i], err = hex.DecodeString(s); err != nil {
+					return fmt.Errorf("invalid --%s: %w", flag, err)
+				}
+			}
+			return printDerivedAddress(cmd, addressutil.ICAHostAddress(args[0], args[1], hashes[0], hashes[1]))
+		},
+	}
+
+	cmd.Flags().String(flagICAAppHash, "", "Hex app hash of the block where the host opened the channel")
+	cmd.Flags().String(flagICADataHash, "", "Hex data hash of the block where the host opened the channel")
+	_ = cmd.MarkFlagRequired(flagICAAppHash)
+	_ = cmd.MarkFlagRequired(flagICADataHash)
 
 	return cmd
 }
 
+// printDerivedAddress prints the address with the --prefix prefix.
+func printDerivedAddress(cmd *cobra.Command, addr sdk.AccAddress) error {
+	bech32prefix, err := cmd.Flags().GetString(flagBech32Prefix)
+	if err != nil {
+		return err
+	}
+	address, err := addressutil.EncodeBech32(addr, bech32prefix)
+	if err != nil {
+		return fmt.Errorf("cannot encode address: %w", err)
+	}
+	cmd.Println(address)
+	return nil
+}
+
 // addDebugCommands injects custom debug commands into another command as children.
 func addDebugCommands(cmd *cobra.Command) *cobra.Command {
 	cmd.AddCommand(AddBech32ConvertCommand())
+	cmd.AddCommand(DumpModuleStateCmd(gaia.DefaultNodeHome))
 	return cmd
 }
@@ -0,0 +1,26 @@
+package cmd_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
+
+	app "github.com/maany-xyz/maany-provider/app"
+	"github.com/maany-xyz/maany-provider/cmd/maanypd/cmd"
+)
+
+func TestICAHostAddressRequiresHashes(t *testing.T) {
+	run := func(args ...string) error {
+		rootCmd := cmd.NewRootCmd()
+		rootCmd.SetArgs(append([]string{"debug", "bech32-convert", "ica-host", "connection-0", "icacontroller-owner"}, args...))
+		return svrcmd.Execute(rootCmd, "", app.DefaultNodeHome)
+	}
+
+	require.Error(t, run("--data-hash", "e3b0"))
+	require.Error(t, run("--app-hash", "5a1f"))
+	require.Error(t, run("--app-hash", "", "--data-hash", "e3b0"))
+	require.Error(t, run("--app-hash", "5a1f", "--data-hash", ""))
+	require.NoError(t, run("--app-hash", "5a1f", "--data-hash", "e3b0"))
+}
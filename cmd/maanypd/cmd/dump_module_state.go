@@ -0,0 +1,246 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/iavl"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	blockrewardstypes "github.com/maany-xyz/maany-provider/x/blockrewards/types"
+	mintburntypes "github.com/maany-xyz/maany-provider/x/mintburn/types"
+)
+
+// StoreEntry is a decoded entry of a module store.
+type StoreEntry struct {
+	// Key is the hex encoded store key.
+	Key string `json:"key"`
+	// Type names the kind of entry, unknown if the key is not recognized.
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+// storeDecoder decodes the entries of a module store.
+type storeDecoder struct {
+	storeKey string
+	decode   func(cdc codec.Codec, key, value []byte) (typ string, v any, err error)
+}
+
+// moduleStoreDecoders are the module stores dump-module-state can decode.
+var moduleStoreDecoders = map[string]storeDecoder{
+	mintburntypes.ModuleName:     {storeKey: mintburntypes.StoreKey, decode: decodeMintburnEntry},
+	blockrewardstypes.ModuleName: {storeKey: blockrewardstypes.StoreKey, decode: decodeBlockRewardsEntry},
+}
+
+// DumpModuleStateCmd returns the dump-module-state cobra Command, which prints
+// the decoded store of a module from the application database of a stopped
+// node.
+func DumpModuleStateCmd(defaultNodeHome string) *cobra.Command {
+	modules := make([]string, 0, len(moduleStoreDecoders))
+	for module := range moduleStoreDecoders {
+		modules = append(modules, module)
+	}
+	sort.Strings(modules)
+
+	cmd := &cobra.Command{
+		Use:   "dump-module-state [module]",
+		Short: "Print the decoded store of a module from the application database",
+		Long: fmt.Sprintf(`Print the decoded store of a module from the application database, at the
+latest height or --height, as a JSON list of entries with the hex key, the
+kind of entry and its value. The modules are %s.
+
+The database is opened read-only where the backend allows it, the node should
+be stopped.
+
+Example:
+	gaiad debug dump-module-state mintburn --height 1200 --home ~/.maany
+	`, strings.Join(modules, ", ")),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			serverCtx := server.GetServerContextFromCmd(cmd)
+
+			if _, ok := moduleStoreDecoders[args[0]]; !ok {
+				return fmt.Errorf("unknown module %s, expected one of %s", args[0], strings.Join(modules, ", "))
+			}
+			height, err := cmd.Flags().GetInt64(flags.FlagHeight)
+			if err != nil {
+				return err
+			}
+
+			db, err := openApplicationDBReadOnly(filepath.Join(clientCtx.HomeDir, "data"), server.GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			entries, err := DumpModuleStore(clientCtx.Codec, db, args[0], height)
+			if err != nil {
+				return err
+			}
+			bz, err := json.MarshalIndent(entries, "", "  ")
+			if err != nil {
+				return err
+			}
+			cmd.Println(string(bz))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(flags.FlagHeight, 0, "Height of the state, the latest if 0")
+
+	return cmd
+}
+
+// openApplicationDBReadOnly opens the application database of the data
+// directory, read-only for goleveldb.
+func openApplicationDBReadOnly(dataDir string, backend dbm.BackendType) (dbm.DB, error) {
+	if backend == dbm.GoLevelDBBackend {
+		return dbm.NewGoLevelDBWithOpts("application", dataDir, &opt.Options{ReadOnly: true})
+	}
+	return dbm.NewDB("application", backend, dataDir)
+}
+
+// DumpModuleStore decodes the entries of the store of module at height, the
+// latest if 0, from the application database.
+func DumpModuleStore(cdc codec.Codec, db dbm.DB, module string, height int64) ([]StoreEntry, error) {
+	decoder, ok := moduleStoreDecoders[module]
+	if !ok {
+		return nil, fmt.Errorf("unknown module %s", module)
+	}
+	if height == 0 {
+		height = rootmulti.GetLatestVersion(db)
+	}
+	if height <= 0 {
+		return nil, fmt.Errorf("no committed state in the application database")
+	}
+
+	// the same prefix rootmulti mounts the store under
+	storeDB := dbm.NewPrefixDB(db, []byte("s/k:"+decoder.storeKey+"/"))
+	key := storetypes.NewKVStoreKey(decoder.storeKey)
+	store, err := iavl.LoadStore(storeDB, log.NewNopLogger(), key, storetypes.CommitID{Version: height}, iavl.DefaultIAVLCacheSize, true, metrics.NewNoOpMetrics())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the %s store at height %d: %w", module, height, err)
+	}
+
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+
+	entries := []StoreEntry{}
+	for ; it.Valid(); it.Next() {
+		entry := StoreEntry{Key: hex.EncodeToString(it.Key())}
+		typ, v, err := decoder.decode(cdc, it.Key(), it.Value())
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s entry %s: %w", typ, entry.Key, err)
+		}
+		entry.Type = typ
+		if typ == "unknown" {
+			v = hex.EncodeToString(it.Value())
+		}
+		if msg, ok := v.(codec.ProtoMarshaler); ok {
+			entry.Value, err = cdc.MarshalJSON(msg)
+		} else {
+			entry.Value, err = json.Marshal(v)
+		}
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// allowedChannelPrefix is the mintburn store prefix of the allowed channels.
+var allowedChannelPrefix = []byte("allowed-channel/")
+
+func decodeMintburnEntry(cdc codec.Codec, key, value []byte) (string, any, error) {
+	switch {
+	case bytes.Equal(key, mintburntypes.EscrowIDCounterKey):
+		if len(value) != 8 {
+			return "escrow_id_counter", nil, fmt.Errorf("expected 8 bytes, got %d", len(value))
+		}
+		return "escrow_id_counter", mintburntypes.Uint64ToString(binary.BigEndian.Uint64(value)), nil
+	case bytes.HasPrefix(key, mintburntypes.EscrowPrefix):
+		var escrow mintburntypes.Escrow
+		return "escrow", &escrow, cdc.Unmarshal(value, &escrow)
+	case bytes.HasPrefix(key, mintburntypes.EscrowIndexPrefix):
+		consumerChainID, denom, _ := bytes.Cut(key[len(mintburntypes.EscrowIndexPrefix):], []byte{0x00})
+		return "escrow_index", map[string]string{
+			"consumer_chain_id": string(consumerChainID),
+			"denom":             string(denom),
+			"escrow_id":         string(value),
+		}, nil
+	case bytes.HasPrefix(key, mintburntypes.AuthorizedICAPrefix):
+		return "authorized_ica", mintburntypes.AuthorizedICA{
+			ConsumerChainId: string(key[len(mintburntypes.AuthorizedICAPrefix):]),
+			Address:         string(value),
+		}, nil
+	case bytes.Equal(key, mintburntypes.ParamsKey):
+		var params mintburntypes.Params
+		return "params", &params, cdc.Unmarshal(value, &params)
+	case bytes.HasPrefix(key, mintburntypes.EscrowRateLimitPrefix):
+		if len(value) != 12 || len(key) < 2 {
+			return "escrow_rate_limit", nil, fmt.Errorf("malformed rate limit entry")
+		}
+		return "escrow_rate_limit", map[string]any{
+			"sender":       sdk.AccAddress(key[2:]).String(),
+			"window_start": mintburntypes.Uint64ToString(binary.BigEndian.Uint64(value[:8])),
+			"count":        binary.BigEndian.Uint32(value[8:]),
+		}, nil
+	case bytes.HasPrefix(key, allowedChannelPrefix):
+		return "allowed_channel", string(key[len(allowedChannelPrefix):]), nil
+	default:
+		return "unknown", nil, nil
+	}
+}
+
+func decodeBlockRewardsEntry(cdc codec.Codec, key, value []byte) (string, any, error) {
+	var (
+		typ string
+		msg codec.ProtoMarshaler
+	)
+	switch {
+	case bytes.Equal(key, blockrewardstypes.ParamsKey):
+		typ, msg = "params", &blockrewardstypes.Params{}
+	case bytes.HasPrefix(key, blockrewardstypes.ValidatorRewardsPrefix):
+		typ, msg = "validator_rewards", &blockrewardstypes.ValidatorRewardRecord{}
+	case bytes.HasPrefix(key, blockrewardstypes.EpochRewardsPrefix):
+		typ, msg = "epoch_rewards", &blockrewardstypes.EpochValidatorRewards{}
+	case bytes.HasPrefix(key, blockrewardstypes.AccruedRewardsPrefix):
+		typ, msg = "accrued_rewards", &blockrewardstypes.AccruedRewards{}
+	case bytes.Equal(key, blockrewardstypes.TotalAccruedKey):
+		typ, msg = "total_accrued", &blockrewardstypes.AccruedRewards{}
+	case bytes.HasPrefix(key, blockrewardstypes.WithheldRewardsPrefix):
+		typ, msg = "withheld_reward", &blockrewardstypes.WithheldReward{}
+	case bytes.Equal(key, blockrewardstypes.TotalWithheldKey):
+		typ, msg = "total_withheld", &blockrewardstypes.AccruedRewards{}
+	case bytes.Equal(key, blockrewardstypes.FeeTotalsKey):
+		typ, msg = "fee_totals", &blockrewardstypes.FeeTotals{}
+	case bytes.HasPrefix(key, blockrewardstypes.WithheldByValidatorPrefix):
+		// index entries carry no value
+		return "withheld_by_validator", nil, nil
+	default:
+		return "unknown", nil, nil
+	}
+	return typ, msg, cdc.Unmarshal(value, msg)
+}
@@ -0,0 +1,98 @@
+package cmd_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	app "github.com/maany-xyz/maany-provider/app"
+	"github.com/maany-xyz/maany-provider/app/params"
+	"github.com/maany-xyz/maany-provider/cmd/maanypd/cmd"
+	blockrewardstypes "github.com/maany-xyz/maany-provider/x/blockrewards/types"
+	mintburntypes "github.com/maany-xyz/maany-provider/x/mintburn/types"
+)
+
+func TestDumpModuleStateCmd(t *testing.T) {
+	home := t.TempDir()
+	cdc := params.MakeEncodingConfig().Marshaler
+
+	// commit two versions of the mintburn and blockrewards stores
+	db, err := dbm.NewGoLevelDB("application", filepath.Join(home, "data"), nil)
+	require.NoError(t, err)
+	ms := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	mintburnKey := storetypes.NewKVStoreKey(mintburntypes.StoreKey)
+	blockrewardsKey := storetypes.NewKVStoreKey(blockrewardstypes.StoreKey)
+	ms.MountStoreWithDB(mintburnKey, storetypes.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(blockrewardsKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	escrow := mintburntypes.Escrow{
+		EscrowId:        "1",
+		ConsumerChainId: "consumer-1",
+		Amount:          sdk.NewInt64Coin("umaany", 100),
+		Status:          mintburntypes.EscrowStatus_ESCROW_STATUS_PENDING,
+	}
+	mintburnStore := ms.GetKVStore(mintburnKey)
+	mintburnStore.Set(mintburntypes.EscrowIDCounterKey, sdk.Uint64ToBigEndian(1))
+	mintburnStore.Set(mintburntypes.EscrowKeyByID(escrow.EscrowId), cdc.MustMarshal(&escrow))
+	mintburnStore.Set(mintburntypes.EscrowIndexKey(escrow.ConsumerChainId, escrow.Amount.Denom), []byte(escrow.EscrowId))
+	mintburnStore.Set(mintburntypes.AuthorizedICAKey("consumer-1"), []byte("maany1ica"))
+	mintburnStore.Set([]byte("allowed-channel/channel-1"), []byte{1})
+	params := blockrewardstypes.DefaultParams()
+	ms.GetKVStore(blockrewardsKey).Set(blockrewardstypes.ParamsKey, cdc.MustMarshal(&params))
+	ms.Commit()
+
+	escrow.Status = mintburntypes.EscrowStatus_ESCROW_STATUS_CLAIMED
+	mintburnStore.Set(mintburntypes.EscrowKeyByID(escrow.EscrowId), cdc.MustMarshal(&escrow))
+	params.BlockRewardAmount = sdk.NewCoin("stake", math.NewInt(5))
+	ms.GetKVStore(blockrewardsKey).Set(blockrewardstypes.ParamsKey, cdc.MustMarshal(&params))
+	ms.Commit()
+	require.NoError(t, db.Close())
+
+	dump := func(args ...string) []cmd.StoreEntry {
+		var out bytes.Buffer
+		rootCmd := cmd.NewRootCmd()
+		rootCmd.SetOut(&out)
+		rootCmd.SetArgs(append([]string{"debug", "dump-module-state"}, append(args, "--home", home)...))
+		require.NoError(t, svrcmd.Execute(rootCmd, "", app.DefaultNodeHome))
+		var entries []cmd.StoreEntry
+		require.NoError(t, json.Unmarshal(out.Bytes(), &entries))
+		return entries
+	}
+	byType := func(entries []cmd.StoreEntry) map[string]json.RawMessage {
+		m := make(map[string]json.RawMessage, len(entries))
+		for _, e := range entries {
+			m[e.Type] = e.Value
+		}
+		return m
+	}
+
+	entries := byType(dump("mintburn", "--height", "1"))
+	require.Len(t, entries, 5)
+	require.JSONEq(t, `"1"`, string(entries["escrow_id_counter"]))
+	require.Contains(t, string(entries["escrow"]), "ESCROW_STATUS_PENDING")
+	require.JSONEq(t, `{"consumer_chain_id":"consumer-1","denom":"umaany","escrow_id":"1"}`, string(entries["escrow_index"]))
+	require.JSONEq(t, `{"consumer_chain_id":"consumer-1","address":"maany1ica"}`, string(entries["authorized_ica"]))
+	require.JSONEq(t, `"channel-1"`, string(entries["allowed_channel"]))
+
+	require.Contains(t, string(byType(dump("mintburn"))["escrow"]), "ESCROW_STATUS_CLAIMED")
+
+	var decoded blockrewardstypes.Params
+	require.NoError(t, cdc.UnmarshalJSON(byType(dump("blockrewards", "--height", "1"))["params"], &decoded))
+	require.Equal(t, blockrewardstypes.DefaultParams().BlockRewardAmount, decoded.BlockRewardAmount)
+	require.NoError(t, cdc.UnmarshalJSON(byType(dump("blockrewards"))["params"], &decoded))
+	require.Equal(t, params.BlockRewardAmount, decoded.BlockRewardAmount)
+}
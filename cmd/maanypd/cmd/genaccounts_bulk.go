@@ -0,0 +1,411 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	authvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+const flagExpectedSupply = "expected-supply"
+
+// Vesting types of a bulk genesis account entry.
+const (
+	vestingTypeContinuous      = "continuous"
+	vestingTypeDelayed         = "delayed"
+	vestingTypePeriodic        = "periodic"
+	vestingTypePermanentLocked = "permanent_locked"
+)
+
+// genesisAccountCSVColumns are the columns of a bulk genesis account CSV file,
+// the header names the ones used, in any order.
+var genesisAccountCSVColumns = []string{
+	"address", "coins", "vesting_type", "vesting_amount",
+	"vesting_start_time", "vesting_end_time", "vesting_periods",
+}
+
+// GenesisAccountEntry is an account of a bulk genesis account file.
+type GenesisAccountEntry struct {
+	Address string `json:"address"`
+	Coins   string `json:"coins"`
+	// VestingType is empty for an account without vesting.
+	VestingType      string                 `json:"vesting_type,omitempty"`
+	VestingAmount    string                 `json:"vesting_amount,omitempty"`
+	VestingStartTime int64                  `json:"vesting_start_time,omitempty"`
+	VestingEndTime   int64                  `json:"vesting_end_time,omitempty"`
+	VestingPeriods   []GenesisVestingPeriod `json:"vesting_periods,omitempty"`
+}
+
+// GenesisVestingPeriod is a period of a periodic vesting schedule.
+type GenesisVestingPeriod struct {
+	// Length is the duration of the period in seconds.
+	Length int64  `json:"length"`
+	Amount string `json:"amount"`
+}
+
+// BulkAddGenesisAccountCmd returns the bulk-add-genesis-account cobra Command,
+// which adds the accounts of a CSV or JSON file to genesis.json.
+func BulkAddGenesisAccountCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bulk-add-genesis-account [file]",
+		Short: "Add the genesis accounts of a CSV or JSON file to genesis.json",
+		Long: `Add the genesis accounts of a CSV or JSON file to genesis.json, in one pass over
+the genesis file. The format is picked by the .csv or .json extension.
+
+A JSON file is a list of accounts:
+
+  [{"address": "maany1...", "coins": "1000umaany"},
+   {"address": "maany1...", "coins": "3600umaany", "vesting_type": "periodic", "vesting_start_time": 1735689600,
+    "vesting_periods": [{"length": 31536000, "amount": "1200umaany"}, {"length": 2592000, "amount": "100umaany"}]}]
+
+A CSV file has a header naming its columns, among address, coins, vesting_type,
+vesting_amount, vesting_start_time, vesting_end_time and vesting_periods. The
+vesting periods are written as length:amount pairs separated by semicolons:
+
+  address,coins,vesting_type,vesting_start_time,vesting_periods
+  maany1...,3600umaany,periodic,1735689600,31536000:1200umaany;2592000:100umaany
+
+The vesting types are:
+  continuous        vesting_amount, vesting_start_time and vesting_end_time
+  delayed           vesting_amount and vesting_end_time
+  periodic          vesting_start_time and vesting_periods, the vesting amount is their sum
+  permanent_locked  vesting_amount, all the coins by default
+
+An address listed several times, or already in genesis.json, gets the sum of
+its balances. It may have one vesting schedule at most. With --expected-supply,
+the file is only written if the resulting bank supply is exactly that.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			config.SetRoot(clientCtx.HomeDir)
+
+			expectedSupplyStr, err := cmd.Flags().GetString(flagExpectedSupply)
+			if err != nil {
+				return err
+			}
+			var expectedSupply sdk.Coins
+			if expectedSupplyStr != "" {
+				if expectedSupply, err = sdk.ParseCoinsNormalized(expectedSupplyStr); err != nil {
+					return fmt.Errorf("failed to parse expected supply: %w", err)
+				}
+			}
+
+			entries, err := ReadGenesisAccountEntries(args[0])
+			if err != nil {
+				return err
+			}
+
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			supply, err := AddGenesisAccounts(clientCtx.Codec, appState, entries)
+			if err != nil {
+				return err
+			}
+			if expectedSupply != nil && !supply.Equal(expectedSupply) {
+				return fmt.Errorf("genesis supply %s is not the expected supply %s", supply, expectedSupply)
+			}
+
+			appStateJSON, err := json.Marshal(appState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal application genesis state: %w", err)
+			}
+
+			genDoc.AppState = appStateJSON
+			if err := genutil.ExportGenesisFile(genDoc, genFile); err != nil {
+				return err
+			}
+			cmd.PrintErrf("Added %d genesis account entries, the supply is %s\n", len(entries), supply)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flagExpectedSupply, "", "Bank supply the genesis must have after the import, unchecked if empty")
+
+	return cmd
+}
+
+// ReadGenesisAccountEntries reads a bulk genesis account file, as CSV or JSON
+// according to its extension.
+func ReadGenesisAccountEntries(path string) ([]GenesisAccountEntry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".json":
+		var entries []GenesisAccountEntry
+		dec := json.NewDecoder(f)
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&entries); err != nil {
+			return nil, fmt.Errorf("failed to decode %s: %w", path, err)
+		}
+		return entries, nil
+	case ".csv":
+		entries, err := readGenesisAccountCSV(f)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		return entries, nil
+	default:
+		return nil, fmt.Errorf("unknown genesis account file extension %q, expected .csv or .json", ext)
+	}
+}
+
+func readGenesisAccountCSV(r io.Reader) ([]GenesisAccountEntry, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+		known := false
+		for _, c := range genesisAccountCSVColumns {
+			known = known || c == name
+		}
+		if !known {
+			return nil, fmt.Errorf("unknown column %q", name)
+		}
+		if _, ok := columns[name]; ok {
+			return nil, fmt.Errorf("duplicate column %q", name)
+		}
+		columns[name] = i
+	}
+	if _, ok := columns["address"]; !ok {
+		return nil, errors.New("missing address column")
+	}
+
+	var entries []GenesisAccountEntry
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return entries, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := cr.FieldPos(0)
+		field := func(name string) string {
+			if i, ok := columns[name]; ok {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		entry := GenesisAccountEntry{
+			Address:       field("address"),
+			Coins:         field("coins"),
+			VestingType:   field("vesting_type"),
+			VestingAmount: field("vesting_amount"),
+		}
+		for name, v := range map[string]*int64{
+			"vesting_start_time": &entry.VestingStartTime,
+			"vesting_end_time":   &entry.VestingEndTime,
+		} {
+			if s := field(name); s != "" {
+				if *v, err = strconv.ParseInt(s, 10, 64); err != nil {
+					return nil, fmt.Errorf("line %d: invalid %s: %w", line, name, err)
+				}
+			}
+		}
+		if s := field("vesting_periods"); s != "" {
+			for _, p := range strings.Split(s, ";") {
+				length, amount, ok := strings.Cut(strings.TrimSpace(p), ":")
+				if !ok {
+					return nil, fmt.Errorf("line %d: invalid vesting period %q, expected length:amount", line, p)
+				}
+				l, err := strconv.ParseInt(length, 10, 64)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: invalid vesting period length: %w", line, err)
+				}
+				entry.VestingPeriods = append(entry.VestingPeriods, GenesisVestingPeriod{Length: l, Amount: amount})
+			}
+		}
+		entries = append(entries, entry)
+	}
+}
+
+// genesisAccount returns the address and coins of the entry, and its vesting
+// account if it has a vesting schedule.
+func (e GenesisAccountEntry) genesisAccount() (sdk.AccAddress, sdk.Coins, authtypes.GenesisAccount, error) {
+	addr, err := sdk.AccAddressFromBech32(e.Address)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("invalid address: %w", err)
+	}
+	coins, err := sdk.ParseCoinsNormalized(e.Coins)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to parse coins: %w", err)
+	}
+	vestingAmt, err := sdk.ParseCoinsNormalized(e.VestingAmount)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to parse vesting amount: %w", err)
+	}
+
+	baseAccount := authtypes.NewBaseAccount(addr, nil, 0, 0)
+	var vestingAccount authtypes.GenesisAccount
+	switch e.VestingType {
+	case "":
+		if !vestingAmt.IsZero() || e.VestingStartTime != 0 || e.VestingEndTime != 0 || len(e.VestingPeriods) > 0 {
+			return nil, nil, nil, errors.New("vesting parameters require a vesting type")
+		}
+		return addr, coins, nil, nil
+	case vestingTypeContinuous:
+		if e.VestingStartTime == 0 || e.VestingEndTime == 0 {
+			return nil, nil, nil, errors.New("continuous vesting requires a start and an end time")
+		}
+		vestingAccount, err = authvesting.NewContinuousVestingAccount(baseAccount, vestingAmt, e.VestingStartTime, e.VestingEndTime)
+	case vestingTypeDelayed:
+		if e.VestingEndTime == 0 {
+			return nil, nil, nil, errors.New("delayed vesting requires an end time")
+		}
+		vestingAccount, err = authvesting.NewDelayedVestingAccount(baseAccount, vestingAmt, e.VestingEndTime)
+	case vestingTypePeriodic:
+		if e.VestingStartTime == 0 || len(e.VestingPeriods) == 0 {
+			return nil, nil, nil, errors.New("periodic vesting requires a start time and periods")
+		}
+		periods := make(authvesting.Periods, len(e.VestingPeriods))
+		for i, p := range e.VestingPeriods {
+			amount, err := sdk.ParseCoinsNormalized(p.Amount)
+			if err != nil {
+				return nil, nil, nil, fmt.Errorf("failed to parse amount of vesting period %d: %w", i, err)
+			}
+			periods[i] = authvesting.Period{Length: p.Length, Amount: amount}
+		}
+		if !vestingAmt.IsZero() && !vestingAmt.Equal(periods.TotalAmount()) {
+			return nil, nil, nil, fmt.Errorf("vesting amount %s is not the total of the vesting periods %s", vestingAmt, periods.TotalAmount())
+		}
+		vestingAccount, err = authvesting.NewPeriodicVestingAccount(baseAccount, periods.TotalAmount(), e.VestingStartTime, periods)
+	case vestingTypePermanentLocked:
+		if vestingAmt.IsZero() {
+			vestingAmt = coins
+		}
+		vestingAccount, err = authvesting.NewPermanentLockedAccount(baseAccount, vestingAmt)
+	default:
+		return nil, nil, nil, fmt.Errorf("unknown vesting type %q", e.VestingType)
+	}
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return addr, coins, vestingAccount, nil
+}
+
+// AddGenesisAccounts adds the accounts of the entries to the auth and bank
+// genesis states of appState and returns the resulting supply. The balances of
+// an address listed several times or already in genesis are summed. An
+// address may only get one vesting schedule, which its total balance must
+// cover.
+func AddGenesisAccounts(cdc codec.Codec, appState map[string]json.RawMessage, entries []GenesisAccountEntry) (sdk.Coins, error) {
+	authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+	accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get accounts from any: %w", err)
+	}
+	accIndex := make(map[string]int, len(accs))
+	for i, acc := range accs {
+		accIndex[acc.GetAddress().String()] = i
+	}
+
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+	balanceIndex := make(map[string]int, len(bankGenState.Balances))
+	for i, balance := range bankGenState.Balances {
+		balanceIndex[balance.Address] = i
+	}
+
+	vesting := make(map[string]bool)
+	for i, e := range entries {
+		addr, coins, vestingAccount, err := e.genesisAccount()
+		if err != nil {
+			return nil, fmt.Errorf("account %d (%s): %w", i, e.Address, err)
+		}
+		key := addr.String()
+
+		j, exists := accIndex[key]
+		switch {
+		case vestingAccount == nil && !exists:
+			accIndex[key] = len(accs)
+			accs = append(accs, authtypes.NewBaseAccount(addr, nil, 0, 0))
+		case vestingAccount != nil && !exists:
+			accIndex[key] = len(accs)
+			accs = append(accs, vestingAccount)
+			vesting[key] = true
+		case vestingAccount != nil:
+			if _, ok := accs[j].(vestingexported.VestingAccount); ok {
+				return nil, fmt.Errorf("account %d (%s): address already has a vesting schedule", i, key)
+			}
+			if _, ok := accs[j].(*authtypes.BaseAccount); !ok {
+				return nil, fmt.Errorf("account %d (%s): cannot add a vesting schedule to a %T", i, key, accs[j])
+			}
+			accs[j] = vestingAccount
+			vesting[key] = true
+		}
+
+		if k, ok := balanceIndex[key]; ok {
+			bankGenState.Balances[k].Coins = bankGenState.Balances[k].Coins.Add(coins...)
+		} else {
+			balanceIndex[key] = len(bankGenState.Balances)
+			bankGenState.Balances = append(bankGenState.Balances, banktypes.Balance{Address: key, Coins: coins})
+		}
+		bankGenState.Supply = bankGenState.Supply.Add(coins...)
+	}
+
+	for _, acc := range accs {
+		key := acc.GetAddress().String()
+		if !vesting[key] {
+			continue
+		}
+		if err := acc.Validate(); err != nil {
+			return nil, fmt.Errorf("failed to validate genesis account %s: %w", key, err)
+		}
+		originalVesting := acc.(vestingexported.VestingAccount).GetOriginalVesting()
+		if balance := bankGenState.Balances[balanceIndex[key]].Coins; !balance.IsAllGTE(originalVesting) {
+			return nil, fmt.Errorf("vesting amount %s of %s is greater than its balance %s", originalVesting, key, balance)
+		}
+	}
+
+	accs = authtypes.SanitizeGenesisAccounts(accs)
+	genAccs, err := authtypes.PackAccounts(accs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert accounts into any's: %w", err)
+	}
+	authGenState.Accounts = genAccs
+	if appState[authtypes.ModuleName], err = cdc.MarshalJSON(&authGenState); err != nil {
+		return nil, fmt.Errorf("failed to marshal auth genesis state: %w", err)
+	}
+
+	bankGenState.Balances = banktypes.SanitizeGenesisBalances(bankGenState.Balances)
+	if appState[banktypes.ModuleName], err = cdc.MarshalJSON(bankGenState); err != nil {
+		return nil, fmt.Errorf("failed to marshal bank genesis state: %w", err)
+	}
+	return bankGenState.Supply, nil
+}
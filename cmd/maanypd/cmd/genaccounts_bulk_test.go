@@ -0,0 +1,123 @@
+package cmd_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	authvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	app "github.com/maany-xyz/maany-provider/app"
+	"github.com/maany-xyz/maany-provider/app/params"
+	"github.com/maany-xyz/maany-provider/cmd/maanypd/cmd"
+)
+
+func TestBulkAddGenesisAccountCmd(t *testing.T) {
+	home := t.TempDir()
+	run := func(args ...string) error {
+		rootCmd := cmd.NewRootCmd()
+		rootCmd.SetArgs(append(args, "--home", home))
+		return svrcmd.Execute(rootCmd, "", app.DefaultNodeHome)
+	}
+	write := func(name, content string) string {
+		path := filepath.Join(t.TempDir(), name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+
+	alice := sdk.AccAddress("alice_______________").String()
+	bob := sdk.AccAddress("bob_________________").String()
+	carol := sdk.AccAddress("carol_______________").String()
+	dave := sdk.AccAddress("dave________________").String()
+
+	require.NoError(t, run("init", "test", "--chain-id", "maany-test"))
+	require.NoError(t, run("genesis", "add-genesis-account", alice, "100umaany"))
+
+	csvFile := write("accounts.csv", fmt.Sprintf(`address,coins,vesting_type,vesting_start_time,vesting_periods
+%s,50umaany,,,
+%s,3600umaany,periodic,1735689600,31536000:1200umaany;2592000:1200umaany;2592000:1200umaany
+%s,"10umaany,5uatom",,,
+`, alice, bob, bob))
+	jsonFile := write("accounts.json", fmt.Sprintf(`[
+  {"address": %q, "coins": "500umaany", "vesting_type": "permanent_locked"},
+  {"address": %q, "coins": "700umaany", "vesting_type": "delayed", "vesting_amount": "600umaany", "vesting_end_time": 1767225600}
+]`, carol, dave))
+
+	// the supply check runs before anything is written
+	require.Error(t, run("genesis", "bulk-add-genesis-account", csvFile, "--expected-supply", "1umaany"))
+	require.NoError(t, run("genesis", "bulk-add-genesis-account", csvFile, "--expected-supply", "3760umaany,5uatom"))
+	require.NoError(t, run("genesis", "bulk-add-genesis-account", jsonFile))
+	// bob already has a vesting schedule
+	require.Error(t, run("genesis", "bulk-add-genesis-account", csvFile))
+
+	appState, _, err := genutiltypes.GenesisStateFromGenFile(filepath.Join(home, "config", "genesis.json"))
+	require.NoError(t, err)
+	cdc := accountCodec()
+
+	authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+	accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	require.NoError(t, err)
+	require.Len(t, accs, 4)
+	byAddr := make(map[string]authtypes.GenesisAccount, len(accs))
+	for _, acc := range accs {
+		byAddr[acc.GetAddress().String()] = acc
+	}
+	require.IsType(t, &authtypes.BaseAccount{}, byAddr[alice])
+	require.IsType(t, &authvesting.PermanentLockedAccount{}, byAddr[carol])
+	require.IsType(t, &authvesting.DelayedVestingAccount{}, byAddr[dave])
+	periodic, ok := byAddr[bob].(*authvesting.PeriodicVestingAccount)
+	require.True(t, ok)
+	require.Len(t, periodic.VestingPeriods, 3)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("umaany", 3600)), periodic.OriginalVesting)
+
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+	require.NoError(t, bankGenState.Validate())
+	balances := make(map[string]sdk.Coins, len(bankGenState.Balances))
+	for _, balance := range bankGenState.Balances {
+		balances[balance.Address] = balance.Coins
+	}
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("umaany", 150)), balances[alice])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("umaany", 3610), sdk.NewInt64Coin("uatom", 5)), balances[bob])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("umaany", 4960), sdk.NewInt64Coin("uatom", 5)), bankGenState.Supply)
+}
+
+func TestBulkAddGenesisAccountInvalid(t *testing.T) {
+	addr := sdk.AccAddress("alice_______________").String()
+	for name, entry := range map[string]cmd.GenesisAccountEntry{
+		"bad address":          {Address: "maany1invalid", Coins: "1umaany"},
+		"vesting without type": {Address: addr, Coins: "1umaany", VestingAmount: "1umaany"},
+		"unknown type":         {Address: addr, Coins: "1umaany", VestingType: "linear"},
+		"continuous no start":  {Address: addr, Coins: "1umaany", VestingType: "continuous", VestingAmount: "1umaany", VestingEndTime: 2},
+		"periodic no periods":  {Address: addr, Coins: "1umaany", VestingType: "periodic", VestingStartTime: 1},
+		"vesting over balance": {Address: addr, Coins: "1umaany", VestingType: "delayed", VestingAmount: "2umaany", VestingEndTime: 2},
+		"periodic amount mismatch": {
+			Address: addr, Coins: "2umaany", VestingType: "periodic", VestingAmount: "1umaany", VestingStartTime: 1,
+			VestingPeriods: []cmd.GenesisVestingPeriod{{Length: 1, Amount: "2umaany"}},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cdc := accountCodec()
+			appState := map[string]json.RawMessage{}
+			_, err := cmd.AddGenesisAccounts(cdc, appState, []cmd.GenesisAccountEntry{entry})
+			require.Error(t, err)
+		})
+	}
+}
+
+// accountCodec returns a codec that can decode the genesis accounts.
+func accountCodec() codec.Codec {
+	encodingConfig := params.MakeEncodingConfig()
+	authtypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	authvesting.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	return encodingConfig.Marshaler
+}
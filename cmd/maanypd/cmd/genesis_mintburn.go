@@ -0,0 +1,273 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	mintburntypes "github.com/maany-xyz/maany-provider/x/mintburn/types"
+)
+
+const (
+	flagEscrowRecipient    = "recipient"
+	flagEscrowExpiryHeight = "expiry-height"
+	flagEscrowExpiryTime   = "expiry-time"
+	flagEscrowFromAccount  = "from-account"
+)
+
+// AddGenesisEscrowCmd returns the add-escrow cobra Command, which adds a
+// pending consumer launch escrow to the mintburn genesis state and moves its
+// amount into the mintburn module account.
+func AddGenesisEscrowCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-escrow [consumer-chain-id] [coin]",
+		Short: "Add a pending mintburn escrow to genesis.json",
+		Long: `Add a pending mintburn escrow to genesis.json. The escrowed coin is credited
+to the mintburn module account in the bank genesis. With --from-account it is
+moved out of the balance of that genesis account, otherwise it is added to the
+supply.
+`,
+		Example: "add-escrow consumer-1 1000000umaany --recipient cosmos1... --from-account cosmos1...",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			consumerChainID := strings.TrimSpace(args[0])
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("failed to parse coin: %w", err)
+			}
+
+			recipient, err := cmd.Flags().GetString(flagEscrowRecipient)
+			if err != nil {
+				return err
+			}
+			expiryHeight, err := cmd.Flags().GetUint64(flagEscrowExpiryHeight)
+			if err != nil {
+				return err
+			}
+			expiryTime, err := cmd.Flags().GetUint64(flagEscrowExpiryTime)
+			if err != nil {
+				return err
+			}
+			fromAccount, err := cmd.Flags().GetString(flagEscrowFromAccount)
+			if err != nil {
+				return err
+			}
+			var from sdk.AccAddress
+			if fromAccount != "" {
+				if from, err = sdk.AccAddressFromBech32(fromAccount); err != nil {
+					return fmt.Errorf("invalid from account: %w", err)
+				}
+			}
+
+			return updateMintburnGenesis(cmd, func(clientCtx client.Context, appState map[string]json.RawMessage, gs *mintburntypes.GenesisState) error {
+				escrow := mintburntypes.Escrow{
+					EscrowId:        mintburntypes.Uint64ToString(gs.EscrowIdCounter + 1),
+					ConsumerChainId: consumerChainID,
+					Amount:          amount,
+					Recipient:       recipient,
+					ExpiryHeight:    expiryHeight,
+					ExpiryTimeUnix:  expiryTime,
+					Status:          mintburntypes.EscrowStatus_ESCROW_STATUS_PENDING,
+				}
+				if err := mintburntypes.ValidateEscrow(escrow); err != nil {
+					return err
+				}
+				gs.Escrows = append(gs.Escrows, escrow)
+				gs.EscrowIdCounter++
+
+				bankGenState := banktypes.GetGenesisStateFromAppState(clientCtx.Codec, appState)
+				if err := escrowGenesisBalance(bankGenState, from, amount); err != nil {
+					return err
+				}
+				if err := bankGenState.Validate(); err != nil {
+					return fmt.Errorf("invalid bank genesis state: %w", err)
+				}
+				bankGenStateBz, err := clientCtx.Codec.MarshalJSON(bankGenState)
+				if err != nil {
+					return fmt.Errorf("failed to marshal bank genesis state: %w", err)
+				}
+				appState[banktypes.ModuleName] = bankGenStateBz
+
+				cmd.Printf("added escrow %s of %s for %s\n", escrow.EscrowId, amount, consumerChainID)
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flagEscrowRecipient, "", "Optional recipient of the escrowed funds on the consumer chain")
+	cmd.Flags().Uint64(flagEscrowExpiryHeight, 0, "Height after which the escrow can be canceled, 0 for none")
+	cmd.Flags().Uint64(flagEscrowExpiryTime, 0, "Unix time after which the escrow can be canceled, 0 for none")
+	cmd.Flags().String(flagEscrowFromAccount, "", "Genesis account whose balance funds the escrow, the supply grows if unset")
+
+	return cmd
+}
+
+// AddGenesisAllowedChannelCmd returns the add-allowed-channel cobra Command,
+// which pre-allows a DEX transfer channel in the mintburn genesis state.
+func AddGenesisAllowedChannelCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-allowed-channel [channel-id]",
+		Short: "Allow a mintburn bridge transfer channel in genesis.json",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			channelID := args[0]
+			if err := host.ChannelIdentifierValidator(channelID); err != nil {
+				return fmt.Errorf("invalid channel id: %w", err)
+			}
+
+			return updateMintburnGenesis(cmd, func(_ client.Context, _ map[string]json.RawMessage, gs *mintburntypes.GenesisState) error {
+				for _, allowed := range gs.AllowedChannels {
+					if allowed == channelID {
+						return fmt.Errorf("channel %s is already allowed", channelID)
+					}
+				}
+				gs.AllowedChannels = append(gs.AllowedChannels, channelID)
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
+
+// SetGenesisAuthorizedICACmd returns the set-authorized-ica cobra Command,
+// which sets the ICA of a consumer chain in the mintburn genesis state.
+func SetGenesisAuthorizedICACmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-authorized-ica [consumer-chain-id] [ica-address]",
+		Short: "Set the interchain account allowed to mark the escrows of a consumer chain claimed in genesis.json",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ica := mintburntypes.AuthorizedICA{
+				ConsumerChainId: strings.TrimSpace(args[0]),
+				Address:         args[1],
+			}
+			if ica.ConsumerChainId == "" {
+				return fmt.Errorf("consumer chain id is required")
+			}
+			if _, err := sdk.AccAddressFromBech32(ica.Address); err != nil {
+				return fmt.Errorf("invalid ICA address: %w", err)
+			}
+
+			return updateMintburnGenesis(cmd, func(_ client.Context, _ map[string]json.RawMessage, gs *mintburntypes.GenesisState) error {
+				for i, existing := range gs.AuthorizedIcas {
+					if existing.ConsumerChainId == ica.ConsumerChainId {
+						gs.AuthorizedIcas[i] = ica
+						return nil
+					}
+				}
+				gs.AuthorizedIcas = append(gs.AuthorizedIcas, ica)
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
+
+// updateMintburnGenesis applies update to the mintburn genesis state of the
+// genesis file, validates it and writes the file back.
+func updateMintburnGenesis(cmd *cobra.Command, update func(client.Context, map[string]json.RawMessage, *mintburntypes.GenesisState) error) error {
+	clientCtx := client.GetClientContextFromCmd(cmd)
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	config := serverCtx.Config
+
+	config.SetRoot(clientCtx.HomeDir)
+
+	genFile := config.GenesisFile()
+	appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+	}
+
+	gs := mintburntypes.DefaultGenesisState()
+	if bz, ok := appState[mintburntypes.ModuleName]; ok {
+		if err := clientCtx.Codec.UnmarshalJSON(bz, gs); err != nil {
+			return fmt.Errorf("failed to unmarshal mintburn genesis state: %w", err)
+		}
+	}
+
+	if err := update(clientCtx, appState, gs); err != nil {
+		return err
+	}
+	if err := gs.Validate(); err != nil {
+		return fmt.Errorf("invalid mintburn genesis state: %w", err)
+	}
+
+	gsBz, err := clientCtx.Codec.MarshalJSON(gs)
+	if err != nil {
+		return fmt.Errorf("failed to marshal mintburn genesis state: %w", err)
+	}
+	appState[mintburntypes.ModuleName] = gsBz
+
+	appStateJSON, err := json.Marshal(appState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal application genesis state: %w", err)
+	}
+
+	genDoc.AppState = appStateJSON
+	return genutil.ExportGenesisFile(genDoc, genFile)
+}
+
+// escrowGenesisBalance credits amount to the mintburn module account, taking
+// it from the balance of from or, if from is empty, adding it to the supply.
+func escrowGenesisBalance(bankGenState *banktypes.GenesisState, from sdk.AccAddress, amount sdk.Coin) error {
+	coins := sdk.NewCoins(amount)
+	moduleAddr := authtypes.NewModuleAddress(mintburntypes.ModuleName).String()
+
+	if from.Empty() {
+		// a new denom is more likely a typo than a token the escrow should create
+		known := bankGenState.Supply.AmountOf(amount.Denom).IsPositive()
+		for _, metadata := range bankGenState.DenomMetadata {
+			known = known || metadata.Base == amount.Denom
+		}
+		if !known {
+			return fmt.Errorf("denom %s is neither in the genesis supply nor in the denom metadata", amount.Denom)
+		}
+		bankGenState.Supply = bankGenState.Supply.Add(coins...)
+	} else {
+		found := false
+		for i, balance := range bankGenState.Balances {
+			if balance.Address != from.String() {
+				continue
+			}
+			remaining, hasNeg := balance.Coins.SafeSub(coins...)
+			if hasNeg {
+				return fmt.Errorf("genesis account %s balance %s does not cover %s", from, balance.Coins, amount)
+			}
+			bankGenState.Balances[i].Coins = remaining
+			found = true
+			break
+		}
+		if !found {
+			return fmt.Errorf("genesis account %s has no balance", from)
+		}
+	}
+
+	for i, balance := range bankGenState.Balances {
+		if balance.Address == moduleAddr {
+			bankGenState.Balances[i].Coins = balance.Coins.Add(coins...)
+			return nil
+		}
+	}
+	bankGenState.Balances = append(bankGenState.Balances, banktypes.Balance{Address: moduleAddr, Coins: coins})
+	bankGenState.Balances = banktypes.SanitizeGenesisBalances(bankGenState.Balances)
+	return nil
+}
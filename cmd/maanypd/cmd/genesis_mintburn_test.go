@@ -0,0 +1,67 @@
+package cmd_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	app "github.com/maany-xyz/maany-provider/app"
+	"github.com/maany-xyz/maany-provider/app/params"
+	"github.com/maany-xyz/maany-provider/cmd/maanypd/cmd"
+	mintburntypes "github.com/maany-xyz/maany-provider/x/mintburn/types"
+)
+
+func TestGenesisMintburnCmds(t *testing.T) {
+	home := t.TempDir()
+	run := func(args ...string) error {
+		rootCmd := cmd.NewRootCmd()
+		rootCmd.SetArgs(append(args, "--home", home))
+		return svrcmd.Execute(rootCmd, "", app.DefaultNodeHome)
+	}
+
+	require.NoError(t, run("init", "test", "--chain-id", "maany-test"))
+	funder := sdk.AccAddress("funder______________").String()
+	ica := sdk.AccAddress("consumer_ica________").String()
+	require.NoError(t, run("genesis", "add-genesis-account", funder, "1000umaany"))
+
+	require.NoError(t, run("genesis", "add-escrow", "consumer-1", "400umaany", "--from-account", funder))
+	require.NoError(t, run("genesis", "add-escrow", "consumer-2", "100umaany"))
+	require.Error(t, run("genesis", "add-escrow", "consumer-1", "700umaany", "--from-account", funder))
+	require.Error(t, run("genesis", "add-escrow", "consumer-1", "1unknown"))
+	require.NoError(t, run("genesis", "add-allowed-channel", "channel-0"))
+	require.Error(t, run("genesis", "add-allowed-channel", "channel-0"))
+	require.Error(t, run("genesis", "set-authorized-ica", "consumer-1", "not-an-address"))
+	require.NoError(t, run("genesis", "set-authorized-ica", "consumer-1", ica))
+
+	appState, _, err := genutiltypes.GenesisStateFromGenFile(filepath.Join(home, "config", "genesis.json"))
+	require.NoError(t, err)
+	cdc := params.MakeEncodingConfig().Marshaler
+
+	var gs mintburntypes.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(appState[mintburntypes.ModuleName], &gs))
+	require.NoError(t, gs.Validate())
+	require.Len(t, gs.Escrows, 2)
+	require.Equal(t, uint64(2), gs.EscrowIdCounter)
+	require.Equal(t, []string{"channel-0"}, gs.AllowedChannels)
+	require.Equal(t, []mintburntypes.AuthorizedICA{{ConsumerChainId: "consumer-1", Address: ica}}, gs.AuthorizedIcas)
+
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+	require.NoError(t, bankGenState.Validate())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("umaany", 1100)), bankGenState.Supply)
+	moduleAddr := authtypes.NewModuleAddress(mintburntypes.ModuleName).String()
+	for _, balance := range bankGenState.Balances {
+		switch balance.Address {
+		case moduleAddr:
+			require.Equal(t, gs.PendingEscrowAmount(), balance.Coins)
+		case funder:
+			require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("umaany", 600)), balance.Coins)
+		}
+	}
+}
@@ -0,0 +1,260 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	providertypes "github.com/cosmos/interchain-security/v5/x/ccv/provider/types"
+	feemarkettypes "github.com/skip-mev/feemarket/x/feemarket/types"
+
+	blockrewardstypes "github.com/maany-xyz/maany-provider/x/blockrewards/types"
+	mintburntypes "github.com/maany-xyz/maany-provider/x/mintburn/types"
+)
+
+const flagMinRewardBlocks = "min-reward-blocks"
+
+// Genesis check statuses.
+const (
+	genesisCheckPass = "pass"
+	genesisCheckFail = "fail"
+)
+
+// GenesisCheck is the outcome of one cross-module genesis check.
+type GenesisCheck struct {
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// GenesisReport is the outcome of all the provider genesis checks.
+type GenesisReport struct {
+	ChainID string         `json:"chain_id"`
+	Valid   bool           `json:"valid"`
+	Checks  []GenesisCheck `json:"checks"`
+}
+
+// ValidateProviderGenesisCmd returns the validate-provider cobra Command, which
+// checks invariants of the provider genesis that span several modules and that
+// the ValidateGenesis of each module cannot see.
+func ValidateProviderGenesisCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate-provider [file]",
+		Short: "Run the provider cross-module checks on a genesis file",
+		Long: `Run the provider cross-module checks on a genesis file, the one of the home by default:
+
+- the blockrewards module account funds at least --min-reward-blocks block rewards
+  on top of the accrued and withheld rewards it owes
+- the block reward denom is the staking bond denom
+- the feemarket fee denom has bank metadata
+- the mintburn module account backs the pending escrows
+- the consumer chains referenced by mintburn are consumers or proposed consumers
+  in the provider genesis
+
+The report is printed as JSON, and the command fails if any check fails.
+`,
+		Args: cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+			config.SetRoot(clientCtx.HomeDir)
+
+			genFile := config.GenesisFile()
+			if len(args) == 1 {
+				genFile = args[0]
+			}
+			minRewardBlocks, err := cmd.Flags().GetUint64(flagMinRewardBlocks)
+			if err != nil {
+				return err
+			}
+
+			appState, appGenesis, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+			report, err := ValidateProviderGenesis(clientCtx.Codec, appState, minRewardBlocks)
+			if err != nil {
+				return err
+			}
+			report.ChainID = appGenesis.ChainID
+
+			bz, err := json.MarshalIndent(report, "", "  ")
+			if err != nil {
+				return err
+			}
+			cmd.Println(string(bz))
+			if !report.Valid {
+				return fmt.Errorf("genesis file %s failed the provider checks", genFile)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Uint64(flagMinRewardBlocks, 100800, "Minimum number of block rewards the blockrewards module account must fund, one week of 6s blocks by default")
+
+	return cmd
+}
+
+// ValidateProviderGenesis runs the provider cross-module checks on the app
+// state. It only fails if a module genesis state cannot be decoded.
+func ValidateProviderGenesis(cdc codec.JSONCodec, appState map[string]json.RawMessage, minRewardBlocks uint64) (GenesisReport, error) {
+	var (
+		bankGenState         banktypes.GenesisState
+		stakingGenState      stakingtypes.GenesisState
+		feemarketGenState    feemarkettypes.GenesisState
+		providerGenState     providertypes.GenesisState
+		blockrewardsGenState blockrewardstypes.GenesisState
+		mintburnGenState     mintburntypes.GenesisState
+	)
+	for module, gs := range map[string]codec.ProtoMarshaler{
+		banktypes.ModuleName:         &bankGenState,
+		stakingtypes.ModuleName:      &stakingGenState,
+		feemarkettypes.ModuleName:    &feemarketGenState,
+		providertypes.ModuleName:     &providerGenState,
+		blockrewardstypes.ModuleName: &blockrewardsGenState,
+		mintburntypes.ModuleName:     &mintburnGenState,
+	} {
+		bz, ok := appState[module]
+		if !ok {
+			return GenesisReport{}, fmt.Errorf("genesis has no %s state", module)
+		}
+		if err := cdc.UnmarshalJSON(bz, gs); err != nil {
+			return GenesisReport{}, fmt.Errorf("failed to unmarshal %s genesis state: %w", module, err)
+		}
+	}
+
+	balances := make(map[string]sdk.Coins, len(bankGenState.Balances))
+	for _, balance := range bankGenState.Balances {
+		balances[balance.Address] = balance.Coins
+	}
+
+	report := GenesisReport{Valid: true}
+	check := func(name string, err error, pass string) {
+		c := GenesisCheck{Name: name, Status: genesisCheckPass, Message: pass}
+		if err != nil {
+			c.Status, c.Message = genesisCheckFail, err.Error()
+			report.Valid = false
+		}
+		report.Checks = append(report.Checks, c)
+	}
+
+	rewardParams := blockrewardsGenState.Params
+	check("blockrewards_funding", func() error {
+		return checkBlockRewardsFunding(blockrewardsGenState, balances, minRewardBlocks)
+	}(), fmt.Sprintf("the blockrewards module account funds at least %d block rewards of %s", minRewardBlocks, rewardParams.BlockRewardAmount))
+
+	bondDenom := stakingGenState.Params.BondDenom
+	check("blockrewards_denom", func() error {
+		if rewardParams.BlockRewardAmount.Denom != bondDenom {
+			return fmt.Errorf("block reward denom %s is not the bond denom %s", rewardParams.BlockRewardAmount.Denom, bondDenom)
+		}
+		return nil
+	}(), fmt.Sprintf("the block reward denom is the bond denom %s", bondDenom))
+
+	feeDenom := feemarketGenState.Params.FeeDenom
+	check("feemarket_fee_denom_metadata", func() error {
+		for _, metadata := range bankGenState.DenomMetadata {
+			if metadata.Base == feeDenom {
+				return nil
+			}
+		}
+		return fmt.Errorf("fee denom %s has no bank metadata", feeDenom)
+	}(), fmt.Sprintf("the fee denom %s has bank metadata", feeDenom))
+
+	pending := mintburnGenState.PendingEscrowAmount()
+	check("mintburn_escrow_backing", func() error {
+		balance := balances[authtypes.NewModuleAddress(mintburntypes.ModuleName).String()]
+		if !balance.IsAllGTE(pending) {
+			return fmt.Errorf("the mintburn module account balance %s does not back the pending escrows %s", coinsString(balance), pending)
+		}
+		return nil
+	}(), fmt.Sprintf("the mintburn module account backs the pending escrows %s", coinsString(pending)))
+
+	check("mintburn_consumer_chains", func() error {
+		return checkMintburnConsumerChains(mintburnGenState, providerGenState)
+	}(), "the consumer chains referenced by mintburn are in the provider genesis")
+
+	return report, nil
+}
+
+// checkBlockRewardsFunding checks that the blockrewards module account holds
+// the accrued and withheld rewards plus minBlocks block rewards.
+func checkBlockRewardsFunding(gs blockrewardstypes.GenesisState, balances map[string]sdk.Coins, minBlocks uint64) error {
+	reserved := sdk.NewCoins()
+	for _, r := range gs.AccruedRewards {
+		reserved = reserved.Add(r.Rewards...)
+	}
+	for _, w := range gs.WithheldRewards {
+		reserved = reserved.Add(w.Rewards...)
+	}
+	reward := gs.Params.BlockRewardAmount
+	if err := reward.Validate(); err != nil {
+		return fmt.Errorf("invalid block reward amount %s: %w", reward, err)
+	}
+	required := reserved.Add(sdk.NewCoin(reward.Denom, reward.Amount.Mul(math.NewIntFromUint64(minBlocks))))
+
+	balance := balances[authtypes.NewModuleAddress(blockrewardstypes.ModuleName).String()]
+	if !balance.IsAllGTE(required) {
+		return fmt.Errorf("the blockrewards module account balance %s is below %s, the %s owed in rewards and %d block rewards of %s",
+			coinsString(balance), required, coinsString(reserved), minBlocks, reward)
+	}
+	return nil
+}
+
+// checkMintburnConsumerChains checks that the consumer chains of the mintburn
+// escrows and authorized ICAs are running or proposed in the provider genesis.
+func checkMintburnConsumerChains(mintburnGenState mintburntypes.GenesisState, providerGenState providertypes.GenesisState) error {
+	consumers := make(map[string]bool)
+	for _, c := range providerGenState.ConsumerStates {
+		consumers[c.ChainId] = true
+	}
+	for _, p := range providerGenState.ConsumerAdditionProposals {
+		consumers[p.ChainId] = true
+	}
+
+	unknown := make(map[string]bool)
+	for _, e := range mintburnGenState.Escrows {
+		if !consumers[e.ConsumerChainId] {
+			unknown[e.ConsumerChainId] = true
+		}
+	}
+	for _, ica := range mintburnGenState.AuthorizedIcas {
+		if !consumers[ica.ConsumerChainId] {
+			unknown[ica.ConsumerChainId] = true
+		}
+	}
+	if len(unknown) == 0 {
+		return nil
+	}
+	chainIDs := make([]string, 0, len(unknown))
+	for chainID := range unknown {
+		chainIDs = append(chainIDs, chainID)
+	}
+	sort.Strings(chainIDs)
+	return fmt.Errorf("consumer chains %s are not in the provider genesis", strings.Join(chainIDs, ", "))
+}
+
+// coinsString formats coins for the report, which would print nothing for no
+// coins.
+func coinsString(coins sdk.Coins) string {
+	if coins.IsZero() {
+		return "0"
+	}
+	return coins.String()
+}
@@ -0,0 +1,96 @@
+package cmd_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	app "github.com/maany-xyz/maany-provider/app"
+	"github.com/maany-xyz/maany-provider/app/params"
+	"github.com/maany-xyz/maany-provider/cmd/maanypd/cmd"
+	blockrewardstypes "github.com/maany-xyz/maany-provider/x/blockrewards/types"
+)
+
+func TestValidateProviderGenesis(t *testing.T) {
+	home := t.TempDir()
+	run := func(args ...string) error {
+		rootCmd := cmd.NewRootCmd()
+		rootCmd.SetArgs(append(args, "--home", home))
+		return svrcmd.Execute(rootCmd, "", app.DefaultNodeHome)
+	}
+
+	require.NoError(t, run("init", "test", "--chain-id", "maany-test"))
+	require.NoError(t, run("genesis", "add-genesis-account", sdk.AccAddress("funder______________").String(), "1000stake"))
+	require.NoError(t, run("genesis", "add-escrow", "consumer-1", "400stake"))
+	require.Error(t, run("genesis", "validate-provider"))
+
+	appState, _, err := genutiltypes.GenesisStateFromGenFile(filepath.Join(home, "config", "genesis.json"))
+	require.NoError(t, err)
+	cdc := params.MakeEncodingConfig().Marshaler
+
+	statuses := func(report cmd.GenesisReport) map[string]string {
+		m := make(map[string]string, len(report.Checks))
+		for _, c := range report.Checks {
+			m[c.Name] = c.Status
+		}
+		return m
+	}
+
+	report, err := cmd.ValidateProviderGenesis(cdc, appState, 10)
+	require.NoError(t, err)
+	require.False(t, report.Valid)
+	require.Equal(t, map[string]string{
+		"blockrewards_funding":         "fail",
+		"blockrewards_denom":           "pass",
+		"feemarket_fee_denom_metadata": "fail",
+		"mintburn_escrow_backing":      "pass",
+		"mintburn_consumer_chains":     "fail",
+	}, statuses(report))
+
+	// fund ten block rewards and add the fee denom metadata
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+	reward := blockrewardstypes.DefaultParams().BlockRewardAmount
+	funding := sdk.NewCoins(sdk.NewCoin(reward.Denom, reward.Amount.MulRaw(10)))
+	bankGenState.Balances = append(bankGenState.Balances, banktypes.Balance{
+		Address: authtypes.NewModuleAddress(blockrewardstypes.ModuleName).String(),
+		Coins:   funding,
+	})
+	bankGenState.Supply = bankGenState.Supply.Add(funding...)
+	bankGenState.DenomMetadata = append(bankGenState.DenomMetadata, banktypes.Metadata{
+		Base:       "stake",
+		Display:    "stake",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "stake"}},
+	})
+	appState[banktypes.ModuleName], err = cdc.MarshalJSON(bankGenState)
+	require.NoError(t, err)
+
+	report, err = cmd.ValidateProviderGenesis(cdc, appState, 10)
+	require.NoError(t, err)
+	require.Equal(t, "pass", statuses(report)["blockrewards_funding"])
+	require.Equal(t, "pass", statuses(report)["feemarket_fee_denom_metadata"])
+	require.Equal(t, "fail", statuses(report)["mintburn_consumer_chains"])
+
+	report, err = cmd.ValidateProviderGenesis(cdc, appState, 11)
+	require.NoError(t, err)
+	require.Equal(t, "fail", statuses(report)["blockrewards_funding"])
+
+	// an empty block reward amount fails the check instead of panicking
+	var blockrewardsGenState blockrewardstypes.GenesisState
+	cdc.MustUnmarshalJSON(appState[blockrewardstypes.ModuleName], &blockrewardsGenState)
+	blockrewardsGenState.Params.BlockRewardAmount = sdk.Coin{}
+	appState[blockrewardstypes.ModuleName], err = cdc.MarshalJSON(&blockrewardsGenState)
+	require.NoError(t, err)
+
+	report, err = cmd.ValidateProviderGenesis(cdc, appState, 10)
+	require.NoError(t, err)
+	require.False(t, report.Valid)
+	require.Equal(t, "fail", statuses(report)["blockrewards_funding"])
+	require.Equal(t, "fail", statuses(report)["blockrewards_denom"])
+}
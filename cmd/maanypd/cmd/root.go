@@ -57,6 +57,7 @@ import (
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 
 	gaia "github.com/maany-xyz/maany-provider/app"
+	metaprotocolstypes "github.com/maany-xyz/maany-provider/x/metaprotocols/types"
 )
 
 // NewRootCmd creates a new root command for simd. It is called once in the
@@ -176,6 +177,8 @@ func initAppConfig() (string, interface{}) {
 		serverconfig.Config
 
 		Wasm wasmtypes.WasmConfig `mapstructure:"wasm"`
+
+		Metaprotocols metaprotocolstypes.IndexConfig `mapstructure:"metaprotocols"`
 	}
 
 	// Can optionally overwrite the SDK's default server config.
@@ -186,9 +189,12 @@ func initAppConfig() (string, interface{}) {
 	customAppConfig := CustomAppConfig{
 		Config: *srvCfg,
 		Wasm:   wasmtypes.DefaultWasmConfig(),
+
+		Metaprotocols: metaprotocolstypes.DefaultIndexConfig(),
 	}
 
-	defaultAppTemplate := serverconfig.DefaultConfigTemplate + wasmtypes.DefaultConfigTemplate()
+	defaultAppTemplate := serverconfig.DefaultConfigTemplate + wasmtypes.DefaultConfigTemplate() +
+		metaprotocolstypes.IndexConfigTemplate(metaprotocolstypes.DefaultIndexConfig())
 
 	return defaultAppTemplate, customAppConfig
 }
@@ -219,7 +225,13 @@ func initRootCmd(rootCmd *cobra.Command,
 	// add keybase, auxiliary RPC, query, and tx child commands
 	rootCmd.AddCommand(
 		server.StatusCommand(),
-		genesisCommand(txConfig, basicManager),
+		genesisCommand(txConfig, basicManager,
+			BulkAddGenesisAccountCmd(gaia.DefaultNodeHome),
+			AddGenesisEscrowCmd(gaia.DefaultNodeHome),
+			AddGenesisAllowedChannelCmd(gaia.DefaultNodeHome),
+			SetGenesisAuthorizedICACmd(gaia.DefaultNodeHome),
+			ValidateProviderGenesisCmd(gaia.DefaultNodeHome),
+		),
 		queryCommand(),
 		txCommand(basicManager),
 		keys.Commands(),
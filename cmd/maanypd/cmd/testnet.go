@@ -51,6 +51,7 @@ var (
 	flagAPIAddress         = "api.address"
 	flagPrintMnemonic      = "print-mnemonic"
 	unsafeStartValidatorFn UnsafeStartValidatorCmdCreator
+	unsafeInitForkHomesFn  func() *cobra.Command
 )
 
 type UnsafeStartValidatorCmdCreator func(ac appCreator) *cobra.Command
@@ -65,6 +66,7 @@ type initArgs struct {
 	numValidators     int
 	outputDir         string
 	startingIPAddress string
+	consumer          consumerArgs
 }
 
 type startArgs struct {
@@ -117,6 +119,9 @@ func NewTestnetCmd(mbm module.BasicManager, genBalIterator banktypes.GenesisBala
 	if unsafeStartValidatorFn != nil {
 		testnetCmd.AddCommand(unsafeStartValidatorFn(appCreator))
 	}
+	if unsafeInitForkHomesFn != nil {
+		testnetCmd.AddCommand(unsafeInitForkHomesFn())
+	}
 
 	return testnetCmd
 }
@@ -134,8 +139,14 @@ or a similar setup where each node has a manually configurable IP address.
 
 Note, strict routability for addresses is turned off in the config file.
 
+With --with-consumer, the consumer chain is launched at genesis: the provider genesis gets a consumer
+addition proposal spawning at genesis time and allows the consumer transfer channel in mintburn, the
+CCV genesis of the consumer is written to <output-dir>/<consumer-chain-id>/ccv_genesis.json to be set
+as its ccvconsumer genesis, and a hermes config for the pair to <output-dir>/hermes/config.toml.
+
 Example:
 	simd testnet init-files --v 4 --output-dir ./.testnets --starting-ip-address 192.168.10.2
+	simd testnet init-files --v 4 --output-dir ./.testnets --with-consumer maanydex --consumer-ip-address 192.168.10.10
 	`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -156,6 +167,11 @@ Example:
 			args.startingIPAddress, _ = cmd.Flags().GetString(flagStartingIPAddress)
 			args.numValidators, _ = cmd.Flags().GetInt(flagNumValidators)
 			args.algo, _ = cmd.Flags().GetString(flags.FlagKeyType)
+			args.consumer.chainID, _ = cmd.Flags().GetString(flagWithConsumer)
+			args.consumer.transferChannel, _ = cmd.Flags().GetString(flagConsumerTransferChan)
+			args.consumer.accountPrefix, _ = cmd.Flags().GetString(flagConsumerAccountPrefix)
+			args.consumer.gasPrice, _ = cmd.Flags().GetString(flagConsumerGasPrice)
+			args.consumer.ipAddress, _ = cmd.Flags().GetString(flagConsumerStartingIPAddr)
 
 			return initTestnetFiles(clientCtx, cmd, config, mbm, genBalIterator, args)
 		},
@@ -166,6 +182,11 @@ Example:
 	cmd.Flags().String(flagNodeDaemonHome, "simd", "Home directory of the node's daemon configuration")
 	cmd.Flags().String(flagStartingIPAddress, "192.168.0.1", "Starting IP address (192.168.0.1 results in persistent peers list ID0@192.168.0.1:46656, ID1@192.168.0.2:46656, ...)")
 	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test)")
+	cmd.Flags().String(flagWithConsumer, "", "Chain ID of a consumer chain to launch at genesis")
+	cmd.Flags().String(flagConsumerTransferChan, "channel-1", "Provider end of the consumer transfer channel to allow in mintburn, the channel after the CCV channel by default")
+	cmd.Flags().String(flagConsumerAccountPrefix, "maany-dex", "Bech32 account prefix of the consumer chain")
+	cmd.Flags().String(flagConsumerGasPrice, "0.0025umaany", "Gas price the relayer pays on the consumer chain")
+	cmd.Flags().String(flagConsumerStartingIPAddr, "127.0.0.1", "IP address of the consumer node the relayer connects to")
 
 	return cmd
 }
@@ -210,6 +231,9 @@ Example:
 
 const nodeDirPerm = 0o755
 
+// testnetValidatorPower is the consensus power of each testnet validator.
+const testnetValidatorPower = 100
+
 // initTestnetFiles initializes testnet files for a testnet to be run in a separate process
 func initTestnetFiles(
 	clientCtx client.Context,
@@ -309,7 +333,7 @@ func initTestnetFiles(
 		genBalances = append(genBalances, banktypes.Balance{Address: addr.String(), Coins: coins.Sort()})
 		genAccounts = append(genAccounts, authtypes.NewBaseAccount(addr, nil, 0, 0))
 
-		valTokens := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+		valTokens := sdk.TokensFromConsensusPower(testnetValidatorPower, sdk.DefaultPowerReduction)
 		createValMsg, err := stakingtypes.NewMsgCreateValidator(
 			sdk.ValAddress(addr).String(),
 			valPubKeys[i],
@@ -363,6 +387,12 @@ func initTestnetFiles(
 		return err
 	}
 
+	if args.consumer.chainID != "" {
+		if err := addTestnetConsumer(clientCtx, args, genFiles, valPubKeys); err != nil {
+			return err
+		}
+	}
+
 	cmd.PrintErrf("Successfully initialized %d node directories\n", args.numValidators)
 	return nil
 }
@@ -0,0 +1,307 @@
+package cmd
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"text/template"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	providertypes "github.com/cosmos/interchain-security/v5/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/v5/x/ccv/types"
+	feemarkettypes "github.com/skip-mev/feemarket/x/feemarket/types"
+
+	mintburntypes "github.com/maany-xyz/maany-provider/x/mintburn/types"
+)
+
+var (
+	flagWithConsumer           = "with-consumer"
+	flagConsumerTransferChan   = "consumer-transfer-channel"
+	flagConsumerAccountPrefix  = "consumer-account-prefix"
+	flagConsumerGasPrice       = "consumer-gas-price"
+	flagConsumerStartingIPAddr = "consumer-ip-address"
+)
+
+// consumerArgs configures the consumer chain launched with the testnet.
+type consumerArgs struct {
+	chainID         string
+	transferChannel string
+	accountPrefix   string
+	gasPrice        string
+	ipAddress       string
+}
+
+// addTestnetConsumer launches the consumer chain at the genesis of the testnet:
+// it adds a consumer addition proposal spawning at genesis time to the
+// provider genesis, allows the transfer channel in the mintburn genesis, and
+// writes the CCV genesis of the consumer chain and a hermes config.
+//
+// The CCV genesis is built offline. Its provider client trusts the genesis
+// validator set at height 1 with a sentinel root, the same way the provider
+// creates the client of the consumer, so the relayer can update it from there.
+func addTestnetConsumer(clientCtx client.Context, args initArgs, genFiles []string, valPubKeys []cryptotypes.PubKey) error {
+	cdc := clientCtx.Codec
+	appGenesis, err := genutiltypes.AppGenesisFromFile(genFiles[0])
+	if err != nil {
+		return err
+	}
+	var appState map[string]json.RawMessage
+	if err := json.Unmarshal(appGenesis.AppState, &appState); err != nil {
+		return err
+	}
+
+	// the initial validator set of the consumer is the genesis validator set
+	// of the provider, without key assignments
+	var (
+		initialValSet []abci.ValidatorUpdate
+		tmVals        []*tmtypes.Validator
+	)
+	for _, pk := range valPubKeys {
+		tmPk, err := cryptocodec.ToCmtProtoPublicKey(pk)
+		if err != nil {
+			return err
+		}
+		initialValSet = append(initialValSet, abci.ValidatorUpdate{PubKey: tmPk, Power: testnetValidatorPower})
+		tmPubKey, err := cryptocodec.ToCmtPubKeyInterface(pk)
+		if err != nil {
+			return err
+		}
+		tmVals = append(tmVals, tmtypes.NewValidator(tmPubKey, testnetValidatorPower))
+	}
+
+	var stakingGenState stakingtypes.GenesisState
+	if err := cdc.UnmarshalJSON(appState[stakingtypes.ModuleName], &stakingGenState); err != nil {
+		return err
+	}
+	var providerGenState providertypes.GenesisState
+	if err := cdc.UnmarshalJSON(appState[providertypes.ModuleName], &providerGenState); err != nil {
+		return err
+	}
+	providerParams := providerGenState.Params
+
+	// CONSUMER CCV GENESIS
+	providerUnbondingPeriod := stakingGenState.Params.UnbondingTime
+	trustPeriod, err := ccvtypes.CalculateTrustPeriod(providerUnbondingPeriod, providerParams.TrustingPeriodFraction)
+	if err != nil {
+		return err
+	}
+	providerClient := *providerParams.TemplateClient
+	providerClient.ChainId = args.chainID
+	providerClient.LatestHeight = clienttypes.NewHeight(clienttypes.ParseChainID(args.chainID), 1)
+	providerClient.TrustingPeriod = trustPeriod
+	providerClient.UnbondingPeriod = providerUnbondingPeriod
+	providerConsState := ibctmtypes.NewConsensusState(
+		appGenesis.GenesisTime,
+		commitmenttypes.NewMerkleRoot([]byte(ibctmtypes.SentinelRoot)),
+		tmtypes.NewValidatorSet(tmVals).Hash(),
+	)
+
+	prop := providertypes.ConsumerAdditionProposal{
+		Title:                             fmt.Sprintf("Launch %s", args.consumer.chainID),
+		Description:                       fmt.Sprintf("Launch %s at the genesis of %s", args.consumer.chainID, args.chainID),
+		ChainId:                           args.consumer.chainID,
+		InitialHeight:                     clienttypes.NewHeight(clienttypes.ParseChainID(args.consumer.chainID), 1),
+		SpawnTime:                         appGenesis.GenesisTime,
+		UnbondingPeriod:                   ccvtypes.DefaultConsumerUnbondingPeriod,
+		CcvTimeoutPeriod:                  ccvtypes.DefaultCCVTimeoutPeriod,
+		TransferTimeoutPeriod:             ccvtypes.DefaultTransferTimeoutPeriod,
+		ConsumerRedistributionFraction:    ccvtypes.DefaultConsumerRedistributeFrac,
+		BlocksPerDistributionTransmission: ccvtypes.DefaultBlocksPerDistributionTransmission,
+		HistoricalEntries:                 ccvtypes.DefaultHistoricalEntries,
+		// every provider validator validates the consumer, as in its initial validator set
+		Top_N: 100,
+	}
+
+	consumerGenesis := ccvtypes.NewInitialConsumerGenesisState(
+		&providerClient,
+		providerConsState,
+		initialValSet,
+		ccvtypes.NewParams(
+			true,
+			prop.BlocksPerDistributionTransmission,
+			prop.DistributionTransmissionChannel,
+			"",
+			prop.CcvTimeoutPeriod,
+			prop.TransferTimeoutPeriod,
+			prop.ConsumerRedistributionFraction,
+			prop.HistoricalEntries,
+			prop.UnbondingPeriod,
+			[]string{},
+			[]string{},
+			ccvtypes.DefaultRetryDelayPeriod,
+		),
+	)
+	if err := consumerGenesis.Validate(); err != nil {
+		return fmt.Errorf("invalid consumer genesis: %w", err)
+	}
+	consumerGenesisJSON, err := cdc.MarshalJSON(consumerGenesis)
+	if err != nil {
+		return err
+	}
+	consumerDir := filepath.Join(args.outputDir, args.consumer.chainID)
+	if err := writeFile("ccv_genesis.json", consumerDir, consumerGenesisJSON); err != nil {
+		return err
+	}
+
+	// PROVIDER GENESIS
+	// the hashes are informational, ICS does not check them
+	genesisHash := sha256.Sum256(consumerGenesisJSON)
+	binaryHash := sha256.Sum256([]byte(args.consumer.chainID))
+	prop.GenesisHash, prop.BinaryHash = genesisHash[:], binaryHash[:]
+	if err := prop.ValidateBasic(); err != nil {
+		return err
+	}
+	providerGenState.ConsumerAdditionProposals = append(providerGenState.ConsumerAdditionProposals, prop)
+	if err := providerGenState.Validate(); err != nil {
+		return err
+	}
+	if appState[providertypes.ModuleName], err = cdc.MarshalJSON(&providerGenState); err != nil {
+		return err
+	}
+
+	var mintburnGenState mintburntypes.GenesisState
+	if err := cdc.UnmarshalJSON(appState[mintburntypes.ModuleName], &mintburnGenState); err != nil {
+		return err
+	}
+	mintburnGenState.AllowedChannels = append(mintburnGenState.AllowedChannels, args.consumer.transferChannel)
+	if err := mintburnGenState.Validate(); err != nil {
+		return err
+	}
+	if appState[mintburntypes.ModuleName], err = cdc.MarshalJSON(&mintburnGenState); err != nil {
+		return err
+	}
+
+	if appGenesis.AppState, err = json.MarshalIndent(appState, "", "  "); err != nil {
+		return err
+	}
+	for _, genFile := range genFiles {
+		if err := appGenesis.SaveAs(genFile); err != nil {
+			return err
+		}
+	}
+
+	// RELAYER CONFIG
+	providerIP, err := getIP(0, args.startingIPAddress)
+	if err != nil {
+		return err
+	}
+	// the provider fees are set by the feemarket, not the minimum gas prices
+	var feemarketGenState feemarkettypes.GenesisState
+	if err := cdc.UnmarshalJSON(appState[feemarkettypes.ModuleName], &feemarketGenState); err != nil {
+		return err
+	}
+	providerGasPrice := sdk.NewDecCoinFromDec(feemarketGenState.Params.FeeDenom, feemarketGenState.Params.MinBaseGasPrice)
+	consumerGasPrice, err := sdk.ParseDecCoin(args.consumer.gasPrice)
+	if err != nil {
+		return fmt.Errorf("invalid consumer gas price: %w", err)
+	}
+	// hermes creates the consumer's client of the provider with trustPeriod, and
+	// the provider's client of the consumer as the provider does
+	consumerTrustPeriod, err := ccvtypes.CalculateTrustPeriod(prop.UnbondingPeriod, providerParams.TrustingPeriodFraction)
+	if err != nil {
+		return err
+	}
+	var hermesConfig bytes.Buffer
+	err = hermesConfigTemplate.Execute(&hermesConfig, []hermesChain{
+		{
+			ID:            args.chainID,
+			Host:          providerIP,
+			AccountPrefix: sdk.GetConfig().GetBech32AccountAddrPrefix(),
+			KeyName:       "rly-provider",
+			GasPrice:      providerGasPrice,
+			TrustPeriod:   hermesDuration(trustPeriod),
+		},
+		{
+			ID:            args.consumer.chainID,
+			Host:          args.consumer.ipAddress,
+			AccountPrefix: args.consumer.accountPrefix,
+			KeyName:       "rly-consumer",
+			GasPrice:      consumerGasPrice,
+			TrustPeriod:   hermesDuration(consumerTrustPeriod),
+			CCVConsumer:   true,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return writeFile("config.toml", filepath.Join(args.outputDir, "hermes"), hermesConfig.Bytes())
+}
+
+type hermesChain struct {
+	ID            string
+	Host          string
+	AccountPrefix string
+	KeyName       string
+	GasPrice      sdk.DecCoin
+	TrustPeriod   string
+	CCVConsumer   bool
+}
+
+// hermesDuration formats d in whole seconds, which hermes parses.
+func hermesDuration(d time.Duration) string {
+	return fmt.Sprintf("%ds", int64(d/time.Second))
+}
+
+var hermesConfigTemplate = template.Must(template.New("hermes").Parse(`[global]
+log_level = 'info'
+
+[mode.clients]
+enabled = true
+refresh = true
+misbehaviour = true
+
+[mode.connections]
+enabled = false
+
+[mode.channels]
+enabled = true
+
+[mode.packets]
+enabled = true
+clear_interval = 100
+clear_on_start = true
+tx_confirmation = true
+
+[rest]
+enabled = true
+host = '0.0.0.0'
+port = 3031
+
+[telemetry]
+enabled = true
+host = '127.0.0.1'
+port = 3001
+{{range .}}
+[[chains]]
+id = '{{.ID}}'
+rpc_addr = 'http://{{.Host}}:26657'
+grpc_addr = 'http://{{.Host}}:9090'
+event_source = { mode = 'push', url = 'ws://{{.Host}}:26657/websocket', batch_delay = '50ms' }
+rpc_timeout = '10s'
+account_prefix = '{{.AccountPrefix}}'
+key_name = '{{.KeyName}}'
+store_prefix = 'ibc'
+max_gas = 6000000
+gas_price = { price = {{.GasPrice.Amount}}, denom = '{{.GasPrice.Denom}}' }
+gas_multiplier = 1.5
+clock_drift = '1m'
+trusting_period = '{{.TrustPeriod}}'
+trust_threshold = { numerator = '1', denominator = '3' }
+{{- if .CCVConsumer}}
+ccv_consumer_chain = true
+{{- end}}
+{{end}}`))
@@ -0,0 +1,63 @@
+package cmd_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	providertypes "github.com/cosmos/interchain-security/v5/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/v5/x/ccv/types"
+
+	app "github.com/maany-xyz/maany-provider/app"
+	"github.com/maany-xyz/maany-provider/app/params"
+	"github.com/maany-xyz/maany-provider/cmd/maanypd/cmd"
+	mintburntypes "github.com/maany-xyz/maany-provider/x/mintburn/types"
+)
+
+func TestTestnetInitFilesWithConsumer(t *testing.T) {
+	home, output := t.TempDir(), t.TempDir()
+	rootCmd := cmd.NewRootCmd()
+	rootCmd.SetArgs([]string{
+		"testnet", "init-files", "--v", "2", "--output-dir", output, "--home", home,
+		"--keyring-backend", "test", "--chain-id", "maany-test", "--with-consumer", "maanydex",
+	})
+	require.NoError(t, svrcmd.Execute(rootCmd, "", app.DefaultNodeHome))
+
+	cdc := params.MakeEncodingConfig().Marshaler
+	for _, node := range []string{"node0", "node1"} {
+		appState, _, err := genutiltypes.GenesisStateFromGenFile(filepath.Join(output, node, "simd", "config", "genesis.json"))
+		require.NoError(t, err)
+
+		var providerGenState providertypes.GenesisState
+		require.NoError(t, cdc.UnmarshalJSON(appState[providertypes.ModuleName], &providerGenState))
+		require.NoError(t, providerGenState.Validate())
+		require.Len(t, providerGenState.ConsumerAdditionProposals, 1)
+		require.Equal(t, "maanydex", providerGenState.ConsumerAdditionProposals[0].ChainId)
+
+		var mintburnGenState mintburntypes.GenesisState
+		require.NoError(t, cdc.UnmarshalJSON(appState[mintburntypes.ModuleName], &mintburnGenState))
+		require.Equal(t, []string{"channel-1"}, mintburnGenState.AllowedChannels)
+	}
+
+	bz, err := os.ReadFile(filepath.Join(output, "maanydex", "ccv_genesis.json"))
+	require.NoError(t, err)
+	var consumerGenesis ccvtypes.ConsumerGenesisState
+	require.NoError(t, cdc.UnmarshalJSON(bz, &consumerGenesis))
+	require.NoError(t, consumerGenesis.Validate())
+	require.Len(t, consumerGenesis.Provider.InitialValSet, 2)
+	require.Equal(t, "maany-test", consumerGenesis.Provider.ClientState.ChainId)
+
+	hermesConfig, err := os.ReadFile(filepath.Join(output, "hermes", "config.toml"))
+	require.NoError(t, err)
+	require.Contains(t, string(hermesConfig), "id = 'maanydex'")
+	require.Contains(t, string(hermesConfig), "ccv_consumer_chain = true")
+	// the provider's trusting period matches the consumer's client of it
+	trustPeriod := consumerGenesis.Provider.ClientState.TrustingPeriod
+	require.Contains(t, string(hermesConfig), fmt.Sprintf("trusting_period = '%ds'", int64(trustPeriod.Seconds())))
+	require.NotContains(t, string(hermesConfig), "14days")
+}
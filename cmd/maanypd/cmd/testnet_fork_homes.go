@@ -0,0 +1,269 @@
+//go:build unsafe_start_local_validator
+// +build unsafe_start_local_validator
+
+package cmd
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	cmtconfig "github.com/cometbft/cometbft/config"
+	tmd25519 "github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/privval"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	flagSourceHome     = "source-home"
+	flagValidatorPower = "power"
+)
+
+// forkNodePortStep is the port offset between the nodes of a fork network.
+const forkNodePortStep = 100
+
+func init() {
+	unsafeInitForkHomesFn = testnetUnsafeInitForkHomesCmd
+}
+
+func testnetUnsafeInitForkHomesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unsafe-init-fork-homes",
+		Short: "Initialize the node homes of a multi-validator local network forked from a node's state",
+		Long: `unsafe-init-fork-homes copies the config and data of a stopped node into "v" node homes, gives each
+node a new consensus key, node key and operator key, binds each node to its own local ports, peers the
+nodes with each other and writes the validators.json file listing the new validators.
+
+Each node is then started with unsafe-start-local-validator and the same validators file, which replaces
+the validator set of the forked state with the new validators.
+
+Example:
+	simd testnet unsafe-init-fork-homes --source-home ~/.simapp --v 4 --output-dir ./.forknet
+	simd testnet unsafe-start-local-validator --home ./.forknet/node0 --validators-file ./.forknet/validators.json
+	`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sourceHome, _ := cmd.Flags().GetString(flagSourceHome)
+			outputDir, _ := cmd.Flags().GetString(flagOutputDir)
+			nodeDirPrefix, _ := cmd.Flags().GetString(flagNodeDirPrefix)
+			numValidators, _ := cmd.Flags().GetInt(flagNumValidators)
+			power, _ := cmd.Flags().GetInt64(flagValidatorPower)
+			keyringBackend, _ := cmd.Flags().GetString(flags.FlagKeyringBackend)
+			algoName, _ := cmd.Flags().GetString(flags.FlagKeyType)
+
+			if sourceHome == "" {
+				return fmt.Errorf("--%s is required", flagSourceHome)
+			}
+			if numValidators < 1 {
+				return fmt.Errorf("--%s must be positive", flagNumValidators)
+			}
+			if power <= 0 {
+				return fmt.Errorf("--%s must be positive", flagValidatorPower)
+			}
+
+			var (
+				validators []localValidatorEntry
+				configs    []*cmtconfig.Config
+				peers      []string
+			)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			for i := 0; i < numValidators; i++ {
+				nodeDirName := fmt.Sprintf("%s%d", nodeDirPrefix, i)
+				nodeDir := filepath.Join(outputDir, nodeDirName)
+
+				// the keys and the signing state of the source node are not copied
+				if err := copyDir(filepath.Join(sourceHome, "config"), filepath.Join(nodeDir, "config"), "priv_validator_key.json", "node_key.json"); err != nil {
+					_ = os.RemoveAll(outputDir)
+					return err
+				}
+				if err := copyDir(filepath.Join(sourceHome, "data"), filepath.Join(nodeDir, "data"), "priv_validator_state.json"); err != nil {
+					_ = os.RemoveAll(outputDir)
+					return err
+				}
+
+				nodeConfig, err := loadCometConfig(nodeDir)
+				if err != nil {
+					return err
+				}
+				p2pPort := 26656 + i*forkNodePortStep
+				nodeConfig.Moniker = nodeDirName
+				nodeConfig.P2P.ListenAddress = fmt.Sprintf("tcp://127.0.0.1:%d", p2pPort)
+				nodeConfig.P2P.AddrBookStrict = false
+				nodeConfig.P2P.AllowDuplicateIP = true
+				nodeConfig.P2P.Seeds = ""
+				nodeConfig.RPC.ListenAddress = fmt.Sprintf("tcp://127.0.0.1:%d", 26657+i*forkNodePortStep)
+				nodeConfig.RPC.PprofListenAddress = fmt.Sprintf("localhost:%d", 6060+i)
+				nodeConfig.StateSync.Enable = false
+				configs = append(configs, nodeConfig)
+
+				if err := setAppPorts(nodeDir, i); err != nil {
+					return err
+				}
+
+				privKey := tmd25519.GenPrivKey()
+				privval.NewFilePV(privKey, nodeConfig.PrivValidatorKeyFile(), nodeConfig.PrivValidatorStateFile()).Save()
+				nodeKey, err := p2p.LoadOrGenNodeKey(nodeConfig.NodeKeyFile())
+				if err != nil {
+					return err
+				}
+				peers = append(peers, fmt.Sprintf("%s@127.0.0.1:%d", nodeKey.ID(), p2pPort))
+
+				kb, err := keyring.New(sdk.KeyringServiceName(), keyringBackend, nodeDir, inBuf, clientCtx.Codec)
+				if err != nil {
+					return err
+				}
+				keyringAlgos, _ := kb.SupportedAlgorithms()
+				algo, err := keyring.NewSigningAlgoFromString(algoName, keyringAlgos)
+				if err != nil {
+					return err
+				}
+				addr, secret, err := testutil.GenerateSaveCoinKey(kb, nodeDirName, "", true, algo)
+				if err != nil {
+					return err
+				}
+				cliPrint, err := json.Marshal(map[string]string{"secret": secret})
+				if err != nil {
+					return err
+				}
+				// save private key seed words
+				if err := writeFile("key_seed.json", nodeDir, cliPrint); err != nil {
+					return err
+				}
+
+				validators = append(validators, localValidatorEntry{
+					Moniker:  nodeDirName,
+					Operator: sdk.ValAddress(addr).String(),
+					PubKey:   base64.StdEncoding.EncodeToString(privKey.PubKey().Bytes()),
+					PrivKey:  base64.StdEncoding.EncodeToString(privKey.Bytes()),
+					Power:    power,
+				})
+			}
+
+			// every node peers with all the others
+			for i, nodeConfig := range configs {
+				others := make([]string, 0, len(peers)-1)
+				for j, peer := range peers {
+					if j != i {
+						others = append(others, peer)
+					}
+				}
+				nodeConfig.P2P.PersistentPeers = strings.Join(others, ",")
+				cmtconfig.WriteConfigFile(filepath.Join(nodeConfig.RootDir, "config", "config.toml"), nodeConfig)
+			}
+
+			bz, err := json.MarshalIndent(localValidatorsFile{Validators: validators}, "", "  ")
+			if err != nil {
+				return err
+			}
+			validatorsFile := filepath.Join(outputDir, "validators.json")
+			if err := writeFile("validators.json", outputDir, bz); err != nil {
+				return err
+			}
+
+			cmd.PrintErrf("Successfully initialized %d node directories, start each node with:\n", numValidators)
+			for _, nodeConfig := range configs {
+				cmd.PrintErrf("  %s testnet unsafe-start-local-validator --home %s --validators-file %s\n", os.Args[0], nodeConfig.RootDir, validatorsFile)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagSourceHome, "", "Home of the stopped node whose config and data are forked")
+	cmd.Flags().Int(flagNumValidators, 4, "Number of validators of the fork network")
+	cmd.Flags().StringP(flagOutputDir, "o", "./.forknet", "Directory to store the node homes and the validators file in")
+	cmd.Flags().String(flagNodeDirPrefix, "node", "Prefix the directory name for each node with (node results in node0, node1, ...)")
+	cmd.Flags().Int64(flagValidatorPower, valVotingPower, "Consensus power of each validator, it is staked as power * 10^6 tokens")
+	cmd.Flags().String(flags.FlagKeyringBackend, keyring.BackendTest, "Select keyring's backend (os|file|test)")
+	cmd.Flags().String(flags.FlagKeyType, string(hd.Secp256k1Type), "Key signing algorithm to generate operator keys for")
+
+	return cmd
+}
+
+// loadCometConfig reads the CometBFT config of a node home.
+func loadCometConfig(home string) (*cmtconfig.Config, error) {
+	v := viper.New()
+	v.SetConfigFile(filepath.Join(home, "config", "config.toml"))
+	if err := v.ReadInConfig(); err != nil {
+		return nil, err
+	}
+	nodeConfig := cmtconfig.DefaultConfig()
+	if err := v.Unmarshal(nodeConfig); err != nil {
+		return nil, err
+	}
+	nodeConfig.SetRoot(home)
+	return nodeConfig, nil
+}
+
+// setAppPorts binds the API and gRPC servers of the i-th node to their own
+// local ports.
+func setAppPorts(home string, i int) error {
+	v := viper.New()
+	v.SetConfigFile(filepath.Join(home, "config", "app.toml"))
+	if err := v.ReadInConfig(); err != nil {
+		return err
+	}
+	v.Set("api.address", fmt.Sprintf("tcp://127.0.0.1:%d", 1317+i*forkNodePortStep))
+	v.Set("grpc.address", fmt.Sprintf("127.0.0.1:%d", 9090+i*forkNodePortStep))
+	return v.WriteConfig()
+}
+
+// copyDir copies the files of src into dst, except the files named in skip.
+func copyDir(src, dst string, skip ...string) error {
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if d.IsDir() {
+			return os.MkdirAll(target, nodeDirPerm)
+		}
+		for _, name := range skip {
+			if d.Name() == name {
+				return nil
+			}
+		}
+
+		in, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		out, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, info.Mode().Perm())
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(out, in); err != nil {
+			_ = out.Close()
+			return err
+		}
+		return out.Close()
+	})
+}
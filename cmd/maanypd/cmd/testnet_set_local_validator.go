@@ -4,11 +4,15 @@
 package cmd
 
 import (
+	"bytes"
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
@@ -26,6 +30,7 @@ import (
 	"cosmossdk.io/log"
 	"github.com/cometbft/cometbft/crypto"
 	tmd25519 "github.com/cometbft/cometbft/crypto/ed25519"
+	tmprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	cmtstate "github.com/cometbft/cometbft/proto/tendermint/state"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	sm "github.com/cometbft/cometbft/state"
@@ -37,25 +42,77 @@ import (
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	providertypes "github.com/cosmos/interchain-security/v5/x/ccv/provider/types"
+
+	blockrewardstypes "github.com/maany-xyz/maany-provider/x/blockrewards/types"
+	mintburntypes "github.com/maany-xyz/maany-provider/x/mintburn/types"
 )
 
 const (
-	valVotingPower int64 = 900000000000000
+	// valVotingPower is the consensus power of a local validator, it is staked
+	// as valVotingPower * 10^6 tokens (the default power reduction).
+	valVotingPower int64 = 900000000
 )
 
 var (
 	flagValidatorOperatorAddress = "validator-operator"
 	flagValidatorPubKey          = "validator-pubkey"
 	flagValidatorPrivKey         = "validator-privkey"
+	flagValidatorsFile           = "validators-file"
 	flagAccountsToFund           = "accounts-to-fund"
+	flagConsumerKeys             = "consumer-keys"
+	flagMintburnResetRoutes      = "mintburn-reset-routes"
+	flagMintburnAllowedChannels  = "mintburn-allowed-channels"
+	flagMintburnAuthorizedICAs   = "mintburn-authorized-icas"
+	flagBlockRewardsParams       = "blockrewards-params"
 )
 
 type valArgs struct {
-	validatorOperatorAddress string           // valoper address
-	validatorConsPubKeyByte  []byte           // validator's consensus public key
-	validatorConsPrivKey     crypto.PrivKey   // validator's consensus private key
-	accountsToFund           []sdk.AccAddress // list of accounts to fund and use for testing later on
-	homeDir                  string
+	validators     []localValidator // validators replacing the existing validator set
+	accountsToFund []sdk.AccAddress // list of accounts to fund and use for testing later on
+	homeDir        string
+
+	mintburnResetRoutes     bool                          // clear the mintburn allowed channels and authorized ICAs
+	mintburnAllowedChannels []string                      // mintburn allowed channels to set
+	mintburnAuthorizedICAs  []mintburntypes.AuthorizedICA // mintburn authorized ICAs to set
+	blockRewardsParamsFile  string                        // file with the blockrewards params to set
+}
+
+type localValidator struct {
+	moniker                  string
+	validatorOperatorAddress string         // valoper address
+	validatorConsPubKeyByte  []byte         // validator's consensus public key
+	validatorConsPrivKey     crypto.PrivKey // validator's consensus private key
+	power                    int64
+	consumerKeys             []consumerKey // consensus keys of the validator on consumer chains
+}
+
+type consumerKey struct {
+	chainID string
+	pubKey  []byte // ed25519 consensus public key
+}
+
+// localValidatorsFile is the --validators-file format, also written by
+// unsafe-init-fork-homes.
+type localValidatorsFile struct {
+	Validators []localValidatorEntry `json:"validators"`
+}
+
+type localValidatorEntry struct {
+	Moniker string `json:"moniker"`
+	// Operator is the valoper address of the validator.
+	Operator string `json:"operator"`
+	// PubKey and PrivKey are the base64 tendermint/PubKeyEd25519 and
+	// tendermint/PrivKeyEd25519 consensus keys of the validator.
+	PubKey  string `json:"pubkey"`
+	PrivKey string `json:"privkey"`
+	// Power is the consensus power of the validator. Its staked tokens are
+	// power times the power reduction.
+	Power int64 `json:"power"`
+	// ConsumerKeys maps consumer chain IDs to the base64 consensus public key
+	// of the validator on that chain.
+	ConsumerKeys map[string]string `json:"consumer_keys,omitempty"`
 }
 
 func init() {
@@ -71,13 +128,30 @@ with the aim of facilitating testing procedures. This command replaces existing
 thereby removing the old validator set and introducing a new set suitable for local testing purposes. By altering the state extracted from the mainnet node,
 it enables developers to configure their local environments to reflect mainnet conditions more accurately.
 
+The new set is either the single validator of the --validator-* flags or the validators listed in --validators-file,
+in which case every node of the local network runs this command with the same file. unsafe-init-fork-homes sets up
+the homes of such a network and writes the file, the accounts of its operators are funded.
+
+The ICS provider state is reset to match: key assignments are dropped and every consumer chain is validated
+by the new validators, under the consumer keys given in --consumer-keys or the validators file, or under their
+provider keys otherwise. The mintburn bridge routes and the blockrewards params can be replaced so that the fork
+does not route packets to mainnet channels and accounts.
+
 Example:
 	simd testnet unsafe-start-local-validator --validator-operator="cosmosvaloper17fjdcqy7g80pn0seexcch5pg0dtvs45p57t97r" --validator-pukey="SLpHEfzQHuuNO9J1BB/hXyiH6c1NmpoIVQ2pMWmyctE=" --validator-privkey="AiayvI2px5CZVl/uOGmacfFjcIBoyk3Oa2JPBO6zEcdIukcR/NAe64070nUEH+FfKIfpzU2amghVDakxabJy0Q==" --accounts-to-fund="cosmos1ju6tlfclulxumtt2kglvnxduj5d93a64r5czge,cosmos1r5v5srda7xfth3hn2s26txvrcrntldjumt8mhl" [other_server_start_flags]
+	simd testnet unsafe-start-local-validator [validator flags] --consumer-keys="maanydex=SLpHEfzQHuuNO9J1BB/hXyiH6c1NmpoIVQ2pMWmyctE=" --mintburn-reset-routes --mintburn-allowed-channels="channel-0" --mintburn-authorized-icas="maanydex=cosmos1ju6tlfclulxumtt2kglvnxduj5d93a64r5czge" --blockrewards-params="blockrewards_params.json"
+	simd testnet unsafe-start-local-validator --home ./.forknet/node0 --validators-file ./.forknet/validators.json [other_server_start_flags]
 	`
 	cmd.Flags().String(flagValidatorOperatorAddress, "", "Validator operator address e.g. cosmosvaloper17fjdcqy7g80pn0seexcch5pg0dtvs45p57t97r")
 	cmd.Flags().String(flagValidatorPubKey, "", "Validator tendermint/PubKeyEd25519 consensus public key from the priv_validato_key.json file")
 	cmd.Flags().String(flagValidatorPrivKey, "", "Validator tendermint/PrivKeyEd25519 consensus private key from the priv_validato_key.json file")
+	cmd.Flags().String(flagValidatorsFile, "", "JSON file listing the validators (operator, pubkey, privkey, power) to use instead of the --validator-* flags")
 	cmd.Flags().String(flagAccountsToFund, "", "Comma-separated list of account addresses that will be funded for testing purposes")
+	cmd.Flags().String(flagConsumerKeys, "", "Comma-separated list of chain-id=pubkey consumer consensus keys of the validator, base64 tendermint/PubKeyEd25519")
+	cmd.Flags().Bool(flagMintburnResetRoutes, false, "Clear the mintburn allowed channels and authorized ICAs")
+	cmd.Flags().String(flagMintburnAllowedChannels, "", "Comma-separated list of transfer channels the mintburn module releases escrows on")
+	cmd.Flags().String(flagMintburnAuthorizedICAs, "", "Comma-separated list of chain-id=address ICAs authorized to mark the escrows of a consumer chain claimed")
+	cmd.Flags().String(flagBlockRewardsParams, "", "JSON file with the blockrewards params to set")
 
 	return cmd
 }
@@ -85,60 +159,209 @@ Example:
 // parse the input flags and returns valArgs
 func getCommandArgs(appOpts servertypes.AppOptions) (valArgs, error) {
 	args := valArgs{}
+
+	validatorsFile := cast.ToString(appOpts.Get(flagValidatorsFile))
+	if validatorsFile != "" {
+		if cast.ToString(appOpts.Get(flagValidatorOperatorAddress)) != "" || cast.ToString(appOpts.Get(flagConsumerKeys)) != "" {
+			return args, fmt.Errorf("--%s cannot be combined with --%s or --%s", flagValidatorsFile, flagValidatorOperatorAddress, flagConsumerKeys)
+		}
+		validators, err := loadLocalValidators(validatorsFile)
+		if err != nil {
+			return args, err
+		}
+		args.validators = validators
+		// fund the operators so that they can sign txs on the fork
+		for _, v := range validators {
+			valAddr, err := sdk.ValAddressFromBech32(v.validatorOperatorAddress)
+			if err != nil {
+				return args, err
+			}
+			args.accountsToFund = append(args.accountsToFund, sdk.AccAddress(valAddr))
+		}
+	} else {
+		v, err := getValidatorFlags(appOpts)
+		if err != nil {
+			return args, err
+		}
+		args.validators = []localValidator{v}
+	}
+
+	// validate  and set accounts to fund
+	accountsString := cast.ToString(appOpts.Get(flagAccountsToFund))
+
+	for _, account := range strings.Split(accountsString, ",") {
+		if account != "" {
+			addr, err := sdk.AccAddressFromBech32(account)
+			if err != nil {
+				return args, fmt.Errorf("invalid bech32 address format %w", err)
+			}
+			args.accountsToFund = append(args.accountsToFund, addr)
+		}
+	}
+
+	// mintburn routes
+	args.mintburnResetRoutes = cast.ToBool(appOpts.Get(flagMintburnResetRoutes))
+	args.mintburnAllowedChannels = splitList(cast.ToString(appOpts.Get(flagMintburnAllowedChannels)))
+	for _, channelID := range args.mintburnAllowedChannels {
+		if err := host.ChannelIdentifierValidator(channelID); err != nil {
+			return args, fmt.Errorf("invalid mintburn allowed channel %w", err)
+		}
+	}
+	for _, entry := range splitList(cast.ToString(appOpts.Get(flagMintburnAuthorizedICAs))) {
+		chainID, address, ok := strings.Cut(entry, "=")
+		if !ok || chainID == "" {
+			return args, fmt.Errorf("invalid authorized ICA %q, expected chain-id=address", entry)
+		}
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return args, fmt.Errorf("invalid authorized ICA address of %s %w", chainID, err)
+		}
+		args.mintburnAuthorizedICAs = append(args.mintburnAuthorizedICAs, mintburntypes.AuthorizedICA{ConsumerChainId: chainID, Address: address})
+	}
+
+	// blockrewards params
+	args.blockRewardsParamsFile = cast.ToString(appOpts.Get(flagBlockRewardsParams))
+
+	// home dir
+	homeDir := cast.ToString(appOpts.Get(flags.FlagHome))
+	if homeDir == "" {
+		return args, fmt.Errorf("invalid home dir")
+	}
+	args.homeDir = homeDir
+
+	return args, nil
+}
+
+// getValidatorFlags parses the single validator of the --validator-* flags.
+func getValidatorFlags(appOpts servertypes.AppOptions) (localValidator, error) {
+	v := localValidator{moniker: "Testnet Validator", power: valVotingPower}
 	// validate and set validator operator address
 	valoperAddress := cast.ToString(appOpts.Get(flagValidatorOperatorAddress))
 	if valoperAddress == "" {
-		return args, fmt.Errorf("invalid validator operator address string")
+		return v, fmt.Errorf("invalid validator operator address string")
 	}
 	_, err := sdk.ValAddressFromBech32(valoperAddress)
 	if err != nil {
-		return args, fmt.Errorf("invalid validator operator address format %w", err)
+		return v, fmt.Errorf("invalid validator operator address format %w", err)
 	}
-	args.validatorOperatorAddress = valoperAddress
+	v.validatorOperatorAddress = valoperAddress
 
 	// validate and set validator pubkey
 	validatorPubKey := cast.ToString(appOpts.Get(flagValidatorPubKey))
 	if validatorPubKey == "" {
-		return args, fmt.Errorf("invalid validator pubkey string")
+		return v, fmt.Errorf("invalid validator pubkey string")
 	}
 	decPubKey, err := base64.StdEncoding.DecodeString(validatorPubKey)
 	if err != nil {
-		return args, fmt.Errorf("cannot decode validator pubkey %w", err)
+		return v, fmt.Errorf("cannot decode validator pubkey %w", err)
 	}
-	args.validatorConsPubKeyByte = []byte(decPubKey)
+	v.validatorConsPubKeyByte = []byte(decPubKey)
 
 	// validate  and set validator privkey
 	validatorPrivKey := cast.ToString(appOpts.Get(flagValidatorPrivKey))
 	if validatorPrivKey == "" {
-		return args, fmt.Errorf("invalid validator private key %w", err)
+		return v, fmt.Errorf("invalid validator private key %w", err)
 	}
 	decPrivKey, err := base64.StdEncoding.DecodeString(validatorPrivKey)
 	if err != nil {
-		return args, fmt.Errorf("cannot decode validator private key %w", err)
+		return v, fmt.Errorf("cannot decode validator private key %w", err)
 	}
-	args.validatorConsPrivKey = tmd25519.PrivKey([]byte(decPrivKey))
+	v.validatorConsPrivKey = tmd25519.PrivKey([]byte(decPrivKey))
 
-	// validate  and set accounts to fund
-	accountsString := cast.ToString(appOpts.Get(flagAccountsToFund))
+	// validate and set consumer keys
+	for _, entry := range splitList(cast.ToString(appOpts.Get(flagConsumerKeys))) {
+		chainID, pubKey, ok := strings.Cut(entry, "=")
+		if !ok || chainID == "" {
+			return v, fmt.Errorf("invalid consumer key %q, expected chain-id=pubkey", entry)
+		}
+		ck, err := parseConsumerKey(chainID, pubKey)
+		if err != nil {
+			return v, err
+		}
+		v.consumerKeys = append(v.consumerKeys, ck)
+	}
 
-	for _, account := range strings.Split(accountsString, ",") {
-		if account != "" {
-			addr, err := sdk.AccAddressFromBech32(account)
+	return v, nil
+}
+
+// loadLocalValidators reads and validates the validators of a --validators-file.
+func loadLocalValidators(path string) ([]localValidator, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var file localValidatorsFile
+	if err := json.Unmarshal(bz, &file); err != nil {
+		return nil, fmt.Errorf("invalid validators file %w", err)
+	}
+	if len(file.Validators) == 0 {
+		return nil, fmt.Errorf("validators file %s lists no validators", path)
+	}
+
+	seen := make(map[string]bool)
+	var totalPower int64
+	validators := make([]localValidator, 0, len(file.Validators))
+	for i, e := range file.Validators {
+		if _, err := sdk.ValAddressFromBech32(e.Operator); err != nil {
+			return nil, fmt.Errorf("validator %d: invalid operator address format %w", i, err)
+		}
+		pubKey, err := base64.StdEncoding.DecodeString(e.PubKey)
+		if err != nil {
+			return nil, fmt.Errorf("validator %d: cannot decode pubkey %w", i, err)
+		}
+		privKey, err := base64.StdEncoding.DecodeString(e.PrivKey)
+		if err != nil {
+			return nil, fmt.Errorf("validator %d: cannot decode private key %w", i, err)
+		}
+		if len(privKey) != tmd25519.PrivateKeySize || !bytes.Equal(tmd25519.PrivKey(privKey).PubKey().Bytes(), pubKey) {
+			return nil, fmt.Errorf("validator %d: private key does not match the pubkey", i)
+		}
+		if e.Power <= 0 {
+			return nil, fmt.Errorf("validator %d: power must be positive", i)
+		}
+		if totalPower += e.Power; e.Power > tmtypes.MaxTotalVotingPower || totalPower > tmtypes.MaxTotalVotingPower {
+			return nil, fmt.Errorf("validator %d: total power exceeds %d", i, tmtypes.MaxTotalVotingPower)
+		}
+		if seen[e.Operator] || seen[e.PubKey] {
+			return nil, fmt.Errorf("validator %d: duplicate operator or pubkey", i)
+		}
+		seen[e.Operator], seen[e.PubKey] = true, true
+
+		v := localValidator{
+			moniker:                  e.Moniker,
+			validatorOperatorAddress: e.Operator,
+			validatorConsPubKeyByte:  pubKey,
+			validatorConsPrivKey:     tmd25519.PrivKey(privKey),
+			power:                    e.Power,
+		}
+		if v.moniker == "" {
+			v.moniker = fmt.Sprintf("Testnet Validator %d", i)
+		}
+		chainIDs := make([]string, 0, len(e.ConsumerKeys))
+		for chainID := range e.ConsumerKeys {
+			chainIDs = append(chainIDs, chainID)
+		}
+		sort.Strings(chainIDs)
+		for _, chainID := range chainIDs {
+			ck, err := parseConsumerKey(chainID, e.ConsumerKeys[chainID])
 			if err != nil {
-				return args, fmt.Errorf("invalid bech32 address format %w", err)
+				return nil, fmt.Errorf("validator %d: %w", i, err)
 			}
-			args.accountsToFund = append(args.accountsToFund, addr)
+			v.consumerKeys = append(v.consumerKeys, ck)
 		}
+		validators = append(validators, v)
 	}
+	return validators, nil
+}
 
-	// home dir
-	homeDir := cast.ToString(appOpts.Get(flags.FlagHome))
-	if homeDir == "" {
-		return args, fmt.Errorf("invalid home dir")
+func parseConsumerKey(chainID, pubKey string) (consumerKey, error) {
+	decPubKey, err := base64.StdEncoding.DecodeString(pubKey)
+	if err != nil {
+		return consumerKey{}, fmt.Errorf("cannot decode consumer key of %s %w", chainID, err)
 	}
-	args.homeDir = homeDir
-
-	return args, nil
+	if len(decPubKey) != tmd25519.PubKeySize {
+		return consumerKey{}, fmt.Errorf("invalid consumer key size of %s", chainID)
+	}
+	return consumerKey{chainID: chainID, pubKey: decPubKey}, nil
 }
 
 // returns gaia app with modified application and consensus states by replacing validator related data
@@ -176,25 +399,105 @@ func (a appCreator) newTestingApp(
 }
 
 func updateApplicationState(app *gaia.GaiaApp, args valArgs) error {
-	pubkey := &ed25519.PubKey{Key: args.validatorConsPubKeyByte}
-	pubkeyAny, err := types.NewAnyWithValue(pubkey)
+	appCtx := app.BaseApp.NewUncachedContext(true, tmproto.Header{})
+
+	// STAKING
+	store := appCtx.KVStore(app.GetKey(stakingtypes.ModuleName))
+	validators, err := app.StakingKeeper.GetAllValidators(appCtx)
 	if err != nil {
 		return err
 	}
+	for _, v := range validators {
+		valConsAddr, err := v.GetConsAddr()
+		if err != nil {
+			return err
+		}
 
-	appCtx := app.BaseApp.NewUncachedContext(true, tmproto.Header{})
+		// delete the old validator record
+		valAddr, err := app.StakingKeeper.ValidatorAddressCodec().StringToBytes(v.GetOperator())
+		if err != nil {
+			return err
+		}
+		store.Delete(stakingtypes.GetValidatorKey(valAddr))
+		store.Delete(stakingtypes.GetValidatorByConsAddrKey(valConsAddr))
+		store.Delete(stakingtypes.GetValidatorsByPowerIndexKey(v, app.StakingKeeper.PowerReduction(appCtx), app.StakingKeeper.ValidatorAddressCodec()))
+		store.Delete(stakingtypes.GetLastValidatorPowerKey(valAddr))
+		if v.IsUnbonding() {
+			app.StakingKeeper.DeleteValidatorQueueTimeSlice(appCtx, v.UnbondingTime, v.UnbondingHeight)
+		}
+	}
+
+	for _, v := range args.validators {
+		if err := addLocalValidator(app, appCtx, v); err != nil {
+			return err
+		}
+	}
+
+	shortVotingPeriod := time.Second * 20
+	expeditedVotingPeriod := time.Second * 10
+	params, err := app.GovKeeper.Params.Get(appCtx)
+	if err != nil {
+		return err
+	}
+	params.VotingPeriod = &shortVotingPeriod
+	params.ExpeditedVotingPeriod = &expeditedVotingPeriod
+	err = app.GovKeeper.Params.Set(appCtx, params)
+	if err != nil {
+		return err
+	}
+	appCtx.Logger().Info("Updated governance voting period", "voting_period", shortVotingPeriod, "expedited_voting_period", expeditedVotingPeriod)
+
+	// BANK
+	bondDenom, err := app.StakingKeeper.BondDenom(appCtx)
+	if err != nil {
+		return err
+	}
+	defaultCoins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000000000000))
+
+	// Fund testnet accounts
+	for _, account := range args.accountsToFund {
+		err := app.BankKeeper.MintCoins(appCtx, minttypes.ModuleName, defaultCoins)
+		if err != nil {
+			return err
+		}
+		err = app.BankKeeper.SendCoinsFromModuleToAccount(appCtx, minttypes.ModuleName, account, defaultCoins)
+		if err != nil {
+			return err
+		}
+	}
+
+	// PROVIDER
+	if err := updateProviderState(app, appCtx, args); err != nil {
+		return err
+	}
+
+	// MINTBURN
+	updateMintburnState(app, appCtx, args)
+
+	// BLOCKREWARDS
+	return updateBlockRewardsState(app, appCtx, args)
+}
+
+// addLocalValidator adds the staking, distribution and slashing records of a
+// validator of the new set.
+func addLocalValidator(app *gaia.GaiaApp, appCtx sdk.Context, v localValidator) error {
+	pubkey := &ed25519.PubKey{Key: v.validatorConsPubKeyByte}
+	pubkeyAny, err := types.NewAnyWithValue(pubkey)
+	if err != nil {
+		return err
+	}
 
 	// STAKING
 	// Create Validator struct for our new validator.
 	newVal := stakingtypes.Validator{
-		OperatorAddress: args.validatorOperatorAddress,
+		OperatorAddress: v.validatorOperatorAddress,
 		ConsensusPubkey: pubkeyAny,
 		Jailed:          false,
 		Status:          stakingtypes.Bonded,
-		Tokens:          math.NewInt(valVotingPower),
+		Tokens:          sdk.TokensFromConsensusPower(v.power, app.StakingKeeper.PowerReduction(appCtx)),
 		DelegatorShares: math.LegacyMustNewDecFromStr("10000000"),
 		Description: stakingtypes.Description{
-			Moniker: "Testnet Validator",
+			Moniker: v.moniker,
 		},
 		Commission: stakingtypes.Commission{
 			CommissionRates: stakingtypes.CommissionRates{
@@ -211,31 +514,6 @@ func updateApplicationState(app *gaia.GaiaApp, args valArgs) error {
 		return err
 	}
 
-	store := appCtx.KVStore(app.GetKey(stakingtypes.ModuleName))
-	validators, err := app.StakingKeeper.GetAllValidators(appCtx)
-	if err != nil {
-		return err
-	}
-	for _, v := range validators {
-		valConsAddr, err := v.GetConsAddr()
-		if err != nil {
-			return err
-		}
-
-		// delete the old validator record
-		valAddr, err := app.StakingKeeper.ValidatorAddressCodec().StringToBytes(v.GetOperator())
-		if err != nil {
-			return err
-		}
-		store.Delete(stakingtypes.GetValidatorKey(valAddr))
-		store.Delete(stakingtypes.GetValidatorByConsAddrKey(valConsAddr))
-		store.Delete(stakingtypes.GetValidatorsByPowerIndexKey(v, app.StakingKeeper.PowerReduction(appCtx), app.StakingKeeper.ValidatorAddressCodec()))
-		store.Delete(stakingtypes.GetLastValidatorPowerKey(valAddr))
-		if v.IsUnbonding() {
-			app.StakingKeeper.DeleteValidatorQueueTimeSlice(appCtx, v.UnbondingTime, v.UnbondingHeight)
-		}
-	}
-
 	// Add our validator to power and last validators store
 	app.StakingKeeper.SetValidator(appCtx, newVal)
 	err = app.StakingKeeper.SetValidatorByConsAddr(appCtx, newVal)
@@ -243,7 +521,7 @@ func updateApplicationState(app *gaia.GaiaApp, args valArgs) error {
 		return err
 	}
 	app.StakingKeeper.SetValidatorByPowerIndex(appCtx, newVal)
-	app.StakingKeeper.SetLastValidatorPower(appCtx, newValAddr, valVotingPower)
+	app.StakingKeeper.SetLastValidatorPower(appCtx, newValAddr, v.power)
 	if err := app.StakingKeeper.Hooks().AfterValidatorCreated(appCtx, newValAddr); err != nil {
 		return err
 	}
@@ -266,46 +544,136 @@ func updateApplicationState(app *gaia.GaiaApp, args valArgs) error {
 
 	app.SlashingKeeper.SetValidatorSigningInfo(appCtx, newConsAddr, newValidatorSigningInfo)
 
-	shortVotingPeriod := time.Second * 20
-	expeditedVotingPeriod := time.Second * 10
-	params, err := app.GovKeeper.Params.Get(appCtx)
-	if err != nil {
-		return err
+	return nil
+}
+
+// updateProviderState drops the key assignments of the old validators and
+// makes the new validators the validators of every consumer chain.
+func updateProviderState(app *gaia.GaiaApp, appCtx sdk.Context, args valArgs) error {
+	k := app.ProviderKeeper
+
+	for _, pk := range k.GetAllValidatorConsumerPubKeys(appCtx, nil) {
+		k.DeleteValidatorConsumerPubKey(appCtx, pk.ChainId, providertypes.NewProviderConsAddress(pk.ProviderAddr))
 	}
-	params.VotingPeriod = &shortVotingPeriod
-	params.ExpeditedVotingPeriod = &expeditedVotingPeriod
-	err = app.GovKeeper.Params.Set(appCtx, params)
-	if err != nil {
-		return err
+	for _, va := range k.GetAllValidatorsByConsumerAddr(appCtx, nil) {
+		k.DeleteValidatorByConsumerAddr(appCtx, va.ChainId, providertypes.NewConsumerConsAddress(va.ConsumerAddr))
 	}
-	appCtx.Logger().Info("Updated governance voting period", "voting_period", shortVotingPeriod, "expedited_voting_period", expeditedVotingPeriod)
 
-	// BANK
-	bondDenom, err := app.StakingKeeper.BondDenom(appCtx)
-	if err != nil {
-		return err
+	for _, v := range args.validators {
+		providerAddr := providertypes.NewProviderConsAddress(sdk.ConsAddress(tmd25519.PubKey(v.validatorConsPubKeyByte).Address()))
+		for _, ck := range v.consumerKeys {
+			if !k.IsConsumerProposedOrRegistered(appCtx, ck.chainID) {
+				return fmt.Errorf("consumer chain %s is neither proposed nor registered", ck.chainID)
+			}
+			pubKey := tmd25519.PubKey(ck.pubKey)
+			k.SetValidatorConsumerPubKey(appCtx, ck.chainID, providerAddr, tmprotocrypto.PublicKey{
+				Sum: &tmprotocrypto.PublicKey_Ed25519{Ed25519: ck.pubKey},
+			})
+			k.SetValidatorByConsumerAddr(appCtx, ck.chainID, providertypes.NewConsumerConsAddress(sdk.ConsAddress(pubKey.Address())), providerAddr)
+		}
 	}
-	defaultCoins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000000000000))
 
-	// Fund testnet accounts
-	for _, account := range args.accountsToFund {
-		err := app.BankKeeper.MintCoins(appCtx, minttypes.ModuleName, defaultCoins)
-		if err != nil {
-			return err
+	for _, chainID := range k.GetAllRegisteredConsumerChainIDs(appCtx) {
+		for _, toPrune := range k.GetAllConsumerAddrsToPrune(appCtx, chainID) {
+			k.DeleteConsumerAddrsToPrune(appCtx, chainID, toPrune.VscId)
 		}
-		err = app.BankKeeper.SendCoinsFromModuleToAccount(appCtx, minttypes.ModuleName, account, defaultCoins)
-		if err != nil {
-			return err
+
+		k.DeleteAllOptedIn(appCtx, chainID)
+		consumerVals := make([]providertypes.ConsumerValidator, 0, len(args.validators))
+		for _, v := range args.validators {
+			consAddr := sdk.ConsAddress(tmd25519.PubKey(v.validatorConsPubKeyByte).Address())
+			providerAddr := providertypes.NewProviderConsAddress(consAddr)
+			consumerKey, found := k.GetValidatorConsumerPubKey(appCtx, chainID, providerAddr)
+			if !found {
+				consumerKey = tmprotocrypto.PublicKey{Sum: &tmprotocrypto.PublicKey_Ed25519{Ed25519: v.validatorConsPubKeyByte}}
+			}
+			k.SetOptedIn(appCtx, chainID, providerAddr)
+			consumerVals = append(consumerVals, providertypes.ConsumerValidator{
+				ProviderConsAddr:  consAddr,
+				Power:             v.power,
+				ConsumerPublicKey: &consumerKey,
+				JoinHeight:        app.LastBlockHeight(),
+			})
 		}
+		k.SetConsumerValSet(appCtx, chainID, consumerVals)
+		appCtx.Logger().Info("Updated consumer validator set", "chain_id", chainID, "validators", len(consumerVals))
 	}
 
 	return nil
 }
 
+// updateMintburnState replaces the mintburn allowed channels and authorized ICAs.
+func updateMintburnState(app *gaia.GaiaApp, appCtx sdk.Context, args valArgs) {
+	k := app.MintBurnKeeper
+	if args.mintburnResetRoutes {
+		for _, channelID := range k.GetAllowedChannels(appCtx) {
+			k.DeleteAllowedChannel(appCtx, channelID)
+		}
+		var chainIDs []string
+		k.IterateAuthorizedICAs(appCtx, func(ica mintburntypes.AuthorizedICA) bool {
+			chainIDs = append(chainIDs, ica.ConsumerChainId)
+			return false
+		})
+		for _, chainID := range chainIDs {
+			k.DeleteAuthorizedICA(appCtx, chainID)
+		}
+		appCtx.Logger().Info("Cleared mintburn allowed channels and authorized ICAs")
+	}
+
+	for _, channelID := range args.mintburnAllowedChannels {
+		k.SetAllowedChannel(appCtx, channelID)
+	}
+	for _, ica := range args.mintburnAuthorizedICAs {
+		k.SetAuthorizedICA(appCtx, ica.ConsumerChainId, ica.Address)
+	}
+	if len(args.mintburnAllowedChannels) > 0 || len(args.mintburnAuthorizedICAs) > 0 {
+		appCtx.Logger().Info("Updated mintburn routes", "allowed_channels", args.mintburnAllowedChannels, "authorized_icas", len(args.mintburnAuthorizedICAs))
+	}
+}
+
+// updateBlockRewardsState replaces the blockrewards params with the ones in
+// the params file, if any.
+func updateBlockRewardsState(app *gaia.GaiaApp, appCtx sdk.Context, args valArgs) error {
+	if args.blockRewardsParamsFile == "" {
+		return nil
+	}
+	bz, err := os.ReadFile(args.blockRewardsParamsFile)
+	if err != nil {
+		return err
+	}
+	var params blockrewardstypes.Params
+	if err := app.AppCodec().UnmarshalJSON(bz, &params); err != nil {
+		return fmt.Errorf("invalid blockrewards params %w", err)
+	}
+	if err := params.Validate(); err != nil {
+		return fmt.Errorf("invalid blockrewards params %w", err)
+	}
+	if err := app.BlockRewardsKeeper.SetParams(appCtx, params); err != nil {
+		return err
+	}
+	appCtx.Logger().Info("Updated blockrewards params", "params", params.String())
+	return nil
+}
+
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
 func updateConsensusState(logger log.Logger, appOpts servertypes.AppOptions, appHeight int64, args valArgs) error {
-	// create validator set from the local validator
-	newTmVal := tmtypes.NewValidator(tmd25519.PubKey(args.validatorConsPubKeyByte), valVotingPower)
-	vals := []*tmtypes.Validator{newTmVal}
+	// create validator set from the local validators
+	vals := make([]*tmtypes.Validator, 0, len(args.validators))
+	privKeys := make(map[string]crypto.PrivKey, len(args.validators))
+	for _, v := range args.validators {
+		newTmVal := tmtypes.NewValidator(tmd25519.PubKey(v.validatorConsPubKeyByte), v.power)
+		vals = append(vals, newTmVal)
+		privKeys[newTmVal.Address.String()] = v.validatorConsPrivKey
+	}
 	validatorSet := tmtypes.NewValidatorSet(vals)
 
 	// CHANGE STATE CONSENSUS STORE
@@ -387,19 +755,22 @@ func updateConsensusState(logger log.Logger, appOpts servertypes.AppOptions, app
 		return errors.New("cannot get the vote from the last commit")
 	}
 
+	// every validator signs the same vote, the signatures follow the order of the validator set
 	voteSignBytes := tmtypes.VoteSignBytes(state.ChainID, vote.ToProto())
-	signatureBytes, err := args.validatorConsPrivKey.Sign(voteSignBytes)
-	if err != nil {
-		return err
+	lastCommit.Signatures = make([]tmtypes.CommitSig, 0, len(validatorSet.Validators))
+	for _, val := range validatorSet.Validators {
+		signatureBytes, err := privKeys[val.Address.String()].Sign(voteSignBytes)
+		if err != nil {
+			return err
+		}
+		lastCommit.Signatures = append(lastCommit.Signatures, tmtypes.CommitSig{
+			BlockIDFlag:      tmtypes.BlockIDFlagCommit,
+			ValidatorAddress: val.Address,
+			Timestamp:        vote.Timestamp,
+			Signature:        []byte(signatureBytes),
+		})
 	}
 
-	lastCommit.Signatures = []tmtypes.CommitSig{{
-		BlockIDFlag:      tmtypes.BlockIDFlagCommit,
-		ValidatorAddress: newTmVal.Address,
-		Timestamp:        vote.Timestamp,
-		Signature:        []byte(signatureBytes),
-	}}
-
 	// if store height is greater than app height and state height, we will remove the last block from the store to avoid
 	// replaying this block to the app. If only the state height is lower, we do not delete the block from the store because
 	// block would not be replayed to the app (the mock app will be used by consensus instead) and only consensus state
@@ -3,7 +3,13 @@ package address
 import (
 	"fmt"
 
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 )
 
 // ConvertBech32Prefix convert bech32 address to specified prefix.
@@ -20,3 +26,28 @@ func ConvertBech32Prefix(address, prefix string) (string, error) {
 
 	return convertedAddress, nil
 }
+
+// ModuleAddress returns the address of the module account of moduleName.
+func ModuleAddress(moduleName string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(moduleName)
+}
+
+// TransferEscrowAddress returns the address escrowing the tokens sent over the
+// transfer channel, as ibc-go computes it.
+func TransferEscrowAddress(portID, channelID string) sdk.AccAddress {
+	return transfertypes.GetEscrowAddress(portID, channelID)
+}
+
+// ICAHostAddress returns the address of the interchain account the host chain
+// creates for the controller port on the connection. As in ibc-go, it depends
+// on the app hash and data hash of the header of the block where the host
+// opened the channel.
+func ICAHostAddress(connectionID, controllerPortID string, appHash, dataHash []byte) sdk.AccAddress {
+	ctx := sdk.Context{}.WithBlockHeader(cmtproto.Header{AppHash: appHash, DataHash: dataHash})
+	return icatypes.GenerateAddress(ctx, connectionID, controllerPortID)
+}
+
+// EncodeBech32 encodes the address with the prefix.
+func EncodeBech32(addr sdk.AccAddress, prefix string) (string, error) {
+	return bech32.ConvertAndEncode(prefix, addr)
+}
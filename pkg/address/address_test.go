@@ -1,10 +1,15 @@
 package address
 
 import (
+	"crypto/sha256"
 	"errors"
 	"testing"
 
+	"github.com/cometbft/cometbft/crypto"
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkaddress "github.com/cosmos/cosmos-sdk/types/address"
 )
 
 func TestConvertBech32Prefix(t *testing.T) {
@@ -41,3 +46,28 @@ func TestConvertBech32Prefix(t *testing.T) {
 		})
 	}
 }
+
+func TestDerivedAddresses(t *testing.T) {
+	// ADR 028 module address
+	require.Equal(t, sdk.AccAddress(crypto.AddressHash([]byte("mintburn"))), ModuleAddress("mintburn"))
+
+	// ADR 028 address hash of the transfer version and port/channel
+	preImage := append([]byte("ics20-1"), 0)
+	preImage = append(preImage, "transfer/channel-1"...)
+	hash := sha256.Sum256(preImage)
+	require.Equal(t, sdk.AccAddress(hash[:20]), TransferEscrowAddress("transfer", "channel-1"))
+	require.NotEqual(t, TransferEscrowAddress("transfer", "channel-1"), TransferEscrowAddress("transfer", "channel-2"))
+
+	// the host module sub-account derived with the connection, port and header hashes
+	appHash, dataHash := []byte{0x01, 0x02}, []byte{0x03}
+	hostModuleAcc := sdkaddress.Module("interchainaccounts", []byte("icahost-accounts"))
+	expected := sdkaddress.Derive(hostModuleAcc, append([]byte("connection-0icacontroller-owner"), 0x01, 0x02, 0x03))
+	require.Equal(t, sdk.AccAddress(expected), ICAHostAddress("connection-0", "icacontroller-owner", appHash, dataHash))
+	require.NotEqual(t, ICAHostAddress("connection-0", "icacontroller-owner", nil, nil), ICAHostAddress("connection-0", "icacontroller-owner", appHash, dataHash))
+
+	addr, err := EncodeBech32(ModuleAddress("mintburn"), "maany")
+	require.NoError(t, err)
+	converted, err := ConvertBech32Prefix(addr, "cosmos")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustBech32ifyAddressBytes("cosmos", ModuleAddress("mintburn")), converted)
+}
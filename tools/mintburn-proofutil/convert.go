@@ -0,0 +1,100 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+
+	// CometBFT proto types for proofs
+	tmcryptopb "github.com/cometbft/cometbft/proto/tendermint/crypto"
+
+	// gogo proto/json for ibc-go types
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/gogo/protobuf/jsonpb"
+)
+
+type rpcResp struct {
+	Result struct {
+		Response struct {
+			ProofOps struct {
+				Ops []struct {
+					Type string `json:"type"`
+					Key  string `json:"key"`  // base64
+					Data string `json:"data"` // base64
+				} `json:"ops"`
+			} `json:"proofOps"`
+		} `json:"response"`
+	} `json:"result"`
+}
+
+func runConvert(args []string, stdin io.Reader, stdout io.Writer) error {
+	fs := newFlagSet("convert")
+	format := fs.String("format", "json", "output format of the proof: json, or base64 of the wire bytes")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if *format != "json" && *format != "base64" {
+		return errorf(codeUsage, "unknown format %q", *format)
+	}
+
+	var r rpcResp
+	if err := json.NewDecoder(stdin).Decode(&r); err != nil {
+		return errorf(codeInvalidInput, "failed to decode the abci_query response: %v", err)
+	}
+	mp, err := convertProofOps(r)
+	if err != nil {
+		return err
+	}
+
+	if *format == "base64" {
+		// for consumers that expect the proof as bytes
+		raw, err := gogoproto.Marshal(&mp)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(stdout, base64.StdEncoding.EncodeToString(raw))
+		return err
+	}
+
+	// gogo JSON, for embedding in genesis
+	m := jsonpb.Marshaler{}
+	if err := m.Marshal(stdout, &mp); err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(stdout)
+	return err
+}
+
+func convertProofOps(r rpcResp) (commitmenttypes.MerkleProof, error) {
+	if len(r.Result.Response.ProofOps.Ops) == 0 {
+		return commitmenttypes.MerkleProof{}, errorf(codeInvalidInput, "the response has no proofOps, query with prove=true")
+	}
+
+	// Build []tmcryptopb.ProofOp (VALUE slice, not []*ProofOp)
+	ops := make([]tmcryptopb.ProofOp, 0, len(r.Result.Response.ProofOps.Ops))
+	for i, o := range r.Result.Response.ProofOps.Ops {
+		key, err := base64.StdEncoding.DecodeString(o.Key)
+		if err != nil {
+			return commitmenttypes.MerkleProof{}, errorf(codeInvalidInput, "proof op %d: invalid key: %v", i, err)
+		}
+		data, err := base64.StdEncoding.DecodeString(o.Data)
+		if err != nil {
+			return commitmenttypes.MerkleProof{}, errorf(codeInvalidInput, "proof op %d: invalid data: %v", i, err)
+		}
+		ops = append(ops, tmcryptopb.ProofOp{
+			Type: o.Type,
+			Key:  key,
+			Data: data,
+		})
+	}
+
+	// Convert to IBC MerkleProof
+	mp, err := commitmenttypes.ConvertProofs(&tmcryptopb.ProofOps{Ops: ops})
+	if err != nil {
+		return commitmenttypes.MerkleProof{}, errorf(codeInvalidInput, "failed to convert the proofOps: %v", err)
+	}
+	return mp, nil
+}
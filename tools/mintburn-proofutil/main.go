@@ -2,67 +2,102 @@
 package main
 
 import (
-	"encoding/base64"
 	"encoding/json"
+	"errors"
+	"flag"
 	"fmt"
+	"io"
 	"os"
+)
 
-	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+const usage = `usage:
+  mintburn-proofutil [convert] [-format json|base64] < abci_query.json
+      turn the proofOps of an abci_query response into an IBC MerkleProof
 
-	// CometBFT proto types for proofs
-	tmcryptopb "github.com/cometbft/cometbft/proto/tendermint/crypto"
+  mintburn-proofutil verify -bundle bundle.json -app-hash <hex|base64>
+  mintburn-proofutil verify -bundle bundle.json -signed-header commit.json -validators validators.json
+      verify the mints of a genesismint bundle against an app hash, or against
+      the app hash of a signed header whose commit the validator set signed
 
-	// gogo proto/json for ibc-go types
+Errors are written to stderr as {"error":{"code":"...","message":"..."}}.
+`
 
-	"github.com/gogo/protobuf/jsonpb"
+// Error codes of the machine-readable errors.
+const (
+	codeUsage              = "usage"
+	codeInvalidInput       = "invalid_input"
+	codeInvalidBundle      = "invalid_bundle"
+	codeInvalidHeader      = "invalid_header"
+	codeValidatorsMismatch = "validators_mismatch"
+	codeInvalidCommit      = "invalid_commit"
+	codeChainIDMismatch    = "chain_id_mismatch"
+	codeHeightMismatch     = "height_mismatch"
+	codeAppHashMismatch    = "app_hash_mismatch"
+	codeInvalidProof       = "invalid_proof"
+	codeValueMismatch      = "value_mismatch"
 )
 
-type rpcResp struct {
-	Result struct {
-		Response struct {
-			ProofOps struct {
-				Ops []struct {
-					Type string `json:"type"`
-					Key  string `json:"key"`  // base64
-					Data string `json:"data"` // base64
-				} `json:"ops"`
-			} `json:"proofOps"`
-		} `json:"response"`
-	} `json:"result"`
+// toolError is an error reported with a stable code.
+type toolError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *toolError) Error() string { return e.Code + ": " + e.Message }
+
+func errorf(code, format string, args ...any) error {
+	return &toolError{Code: code, Message: fmt.Sprintf(format, args...)}
 }
 
 func main() {
-	var r rpcResp
-	if err := json.NewDecoder(os.Stdin).Decode(&r); err != nil {
-		panic(err)
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	cmd := "convert"
+	if len(args) > 0 && (args[0] == "convert" || args[0] == "verify" || args[0] == "help") {
+		cmd, args = args[0], args[1:]
 	}
 
-	// Build []tmcryptopb.ProofOp (VALUE slice, not []*ProofOp)
-	ops := make([]tmcryptopb.ProofOp, 0, len(r.Result.Response.ProofOps.Ops))
-	for _, o := range r.Result.Response.ProofOps.Ops {
-		key, err := base64.StdEncoding.DecodeString(o.Key)
-		if err != nil { panic(err) }
-		data, err := base64.StdEncoding.DecodeString(o.Data)
-		if err != nil { panic(err) }
-		ops = append(ops, tmcryptopb.ProofOp{
-			Type: o.Type,
-			Key:  key,
-			Data: data,
-		})
+	var err error
+	switch cmd {
+	case "convert":
+		err = runConvert(args, stdin, stdout)
+	case "verify":
+		err = runVerify(args, stdout)
+	default:
+		fmt.Fprint(stdout, usage)
+		return 0
+	}
+	if err == nil {
+		return 0
 	}
-	tm := &tmcryptopb.ProofOps{Ops: ops}
 
-	// Convert to IBC MerkleProof
-	mp, err := commitmenttypes.ConvertProofs(tm)
-	if err != nil { panic(err) }
+	var te *toolError
+	if !errors.As(err, &te) {
+		te = &toolError{Code: codeInvalidInput, Message: err.Error()}
+	}
+	_ = json.NewEncoder(stderr).Encode(struct {
+		Error *toolError `json:"error"`
+	}{te})
+	if te.Code == codeUsage {
+		return 2
+	}
+	return 1
+}
 
-	// ---- Option A: print gogo JSON (nice for embedding in genesis) ----
-	m := jsonpb.Marshaler{}
-	if err := m.Marshal(os.Stdout, &mp); err != nil { panic(err) }
-	fmt.Println()
+func newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	return fs
+}
 
-	// // ---- Option B: print base64 of wire bytes (if your consumer expects bytes) ----
-	// raw, err := gogoproto.Marshal(&mp)
-	// if err != nil { panic(err) }
-	// fmt.Println(base64.StdEncoding.EncodeToString(raw))
+func parseFlags(fs *flag.FlagSet, args []string) error {
+	if err := fs.Parse(args); err != nil {
+		return errorf(codeUsage, "%v", err)
+	}
+	if fs.NArg() > 0 {
+		return errorf(codeUsage, "unexpected arguments %v", fs.Args())
+	}
+	return nil
 }
@@ -0,0 +1,231 @@
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmtversion "github.com/cometbft/cometbft/proto/tendermint/version"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cometbft/cometbft/version"
+	"github.com/cosmos/gogoproto/jsonpb"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+
+	"github.com/maany-xyz/maany-provider/x/mintburn/client/cli"
+	mintburntypes "github.com/maany-xyz/maany-provider/x/mintburn/types"
+)
+
+const testChainID = "maany-local-1"
+
+// provenBundle commits an escrow and returns its bundle and the app hash it
+// is proven against.
+func provenBundle(t *testing.T) (cli.LaunchBundle, []byte, int64) {
+	t.Helper()
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	storeKey := storetypes.NewKVStoreKey(mintburntypes.StoreKey)
+	ms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	ms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	escrow := mintburntypes.Escrow{
+		EscrowId:        "1",
+		ConsumerChainId: "maanydex",
+		Amount:          sdk.NewCoin("umaany", math.NewInt(1000)),
+		Status:          mintburntypes.EscrowStatus_ESCROW_STATUS_PENDING,
+	}
+	key := mintburntypes.EscrowKeyByID(escrow.EscrowId)
+	value := cdc.MustMarshal(&escrow)
+	ms.GetKVStore(storeKey).Set(key, value)
+	commit := ms.Commit()
+
+	res, err := ms.Query(&storetypes.RequestQuery{
+		Path:   fmt.Sprintf("/%s/key", mintburntypes.StoreKey),
+		Data:   key,
+		Height: commit.Version,
+		Prove:  true,
+	})
+	require.NoError(t, err)
+	proof, err := commitmenttypes.ConvertProofs(res.ProofOps)
+	require.NoError(t, err)
+	var proofJSON bytes.Buffer
+	require.NoError(t, (&jsonpb.Marshaler{}).Marshal(&proofJSON, &proof))
+
+	return cli.LaunchBundle{
+		Params: cli.LaunchBundleParams{
+			ProviderChainID: testChainID,
+			GenesisTrustedRoot: cli.GenesisTrustedRoot{
+				RevisionNumber: "0",
+				RevisionHeight: strconv.FormatInt(commit.Version, 10),
+				Hash:           base64.StdEncoding.EncodeToString(commit.Hash),
+			},
+			UseGenesisTrustedRoot: true,
+		},
+		Mints: []cli.LaunchBundleMint{{
+			MerkleProof:               proofJSON.Bytes(),
+			ProofHeightRevisionHeight: uint64(commit.Version),
+			KeyPath:                   []string{mintburntypes.StoreKey, hex.EncodeToString(key)},
+			Value:                     base64.StdEncoding.EncodeToString(value),
+			ProviderChainID:           testChainID,
+			AmountDenom:               "umaany",
+			AmountValue:               "1000",
+			EscrowID:                  "1",
+		}},
+		ClaimedEscrowIDs: []string{},
+	}, commit.Hash, commit.Version
+}
+
+// signedHeader returns the header of height with appHash, signed by a new
+// validator set.
+func signedHeader(t *testing.T, height int64, appHash []byte) (*cmttypes.SignedHeader, *cmttypes.ValidatorSet) {
+	t.Helper()
+	vals, privVals := cmttypes.RandValidatorSet(4, 10)
+	header := &cmttypes.Header{
+		Version:            cmtversion.Consensus{Block: version.BlockProtocol},
+		ChainID:            testChainID,
+		Height:             height,
+		Time:               time.Now().UTC(),
+		ValidatorsHash:     vals.Hash(),
+		NextValidatorsHash: vals.Hash(),
+		AppHash:            appHash,
+		ProposerAddress:    vals.Proposer.Address,
+	}
+	blockID := cmttypes.BlockID{Hash: header.Hash(), PartSetHeader: cmttypes.PartSetHeader{Total: 1, Hash: make([]byte, 32)}}
+	voteSet := cmttypes.NewVoteSet(testChainID, height, 0, cmtproto.PrecommitType, vals)
+	extCommit, err := cmttypes.MakeExtCommit(blockID, height, 0, voteSet, privVals, time.Now(), false)
+	require.NoError(t, err)
+	return &cmttypes.SignedHeader{Header: header, Commit: extCommit.ToCommit()}, vals
+}
+
+func writeJSON(t *testing.T, dir, name string, bz []byte) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, bz, 0o600))
+	return path
+}
+
+func runTool(args ...string) (int, string, toolError) {
+	var stdout, stderr bytes.Buffer
+	code := run(args, bytes.NewReader(nil), &stdout, &stderr)
+	var res struct {
+		Error toolError `json:"error"`
+	}
+	_ = json.Unmarshal(stderr.Bytes(), &res)
+	return code, stdout.String(), res.Error
+}
+
+func TestVerifyAppHash(t *testing.T) {
+	dir := t.TempDir()
+	bundle, appHash, _ := provenBundle(t)
+	bz, err := json.Marshal(bundle)
+	require.NoError(t, err)
+	bundlePath := writeJSON(t, dir, "bundle.json", bz)
+
+	code, out, _ := runTool("verify", "-bundle", bundlePath, "-app-hash", hex.EncodeToString(appHash))
+	require.Equal(t, 0, code)
+	var res verifyResult
+	require.NoError(t, json.Unmarshal([]byte(out), &res))
+	require.True(t, res.Verified)
+	require.Equal(t, []string{"1"}, res.EscrowIDs)
+
+	code, _, terr := runTool("verify", "-bundle", bundlePath, "-app-hash", hex.EncodeToString(make([]byte, 32)))
+	require.Equal(t, 1, code)
+	require.Equal(t, codeAppHashMismatch, terr.Code)
+
+	// a proof that does not match the trusted root
+	bundle.Params.GenesisTrustedRoot.Hash = base64.StdEncoding.EncodeToString(make([]byte, 32))
+	bz, err = json.Marshal(bundle)
+	require.NoError(t, err)
+	tamperedPath := writeJSON(t, dir, "tampered.json", bz)
+	code, _, terr = runTool("verify", "-bundle", tamperedPath, "-app-hash", hex.EncodeToString(make([]byte, 32)))
+	require.Equal(t, 1, code)
+	require.Equal(t, codeInvalidProof, terr.Code)
+
+	// a bundle claiming more than is escrowed
+	bundle, appHash, _ = provenBundle(t)
+	bundle.Mints[0].AmountValue = "1000000"
+	bz, err = json.Marshal(bundle)
+	require.NoError(t, err)
+	inflatedPath := writeJSON(t, dir, "inflated.json", bz)
+	code, _, terr = runTool("verify", "-bundle", inflatedPath, "-app-hash", base64.StdEncoding.EncodeToString(appHash))
+	require.Equal(t, 1, code)
+	require.Equal(t, codeValueMismatch, terr.Code)
+
+	code, _, terr = runTool("verify", "-bundle", bundlePath)
+	require.Equal(t, 2, code)
+	require.Equal(t, codeUsage, terr.Code)
+}
+
+func TestVerifySignedHeader(t *testing.T) {
+	dir := t.TempDir()
+	bundle, appHash, height := provenBundle(t)
+	bz, err := json.Marshal(bundle)
+	require.NoError(t, err)
+	bundlePath := writeJSON(t, dir, "bundle.json", bz)
+
+	sh, vals := signedHeader(t, height+1, appHash)
+	bz, err = cmtjson.Marshal(sh)
+	require.NoError(t, err)
+	headerPath := writeJSON(t, dir, "commit.json", []byte(`{"result":{"signed_header":`+string(bz)+`,"canonical":true}}`))
+	bz, err = cmtjson.Marshal(vals.Validators)
+	require.NoError(t, err)
+	valsPath := writeJSON(t, dir, "validators.json", bz)
+
+	code, out, terr := runTool("verify", "-bundle", bundlePath, "-signed-header", headerPath, "-validators", valsPath)
+	require.Equal(t, 0, code, terr.Message)
+	var res verifyResult
+	require.NoError(t, json.Unmarshal([]byte(out), &res))
+	require.Equal(t, height+1, res.Height)
+
+	// validators that did not sign the header
+	otherVals, _ := cmttypes.RandValidatorSet(4, 10)
+	bz, err = cmtjson.Marshal(otherVals.Validators)
+	require.NoError(t, err)
+	otherValsPath := writeJSON(t, dir, "other_validators.json", bz)
+	code, _, terr = runTool("verify", "-bundle", bundlePath, "-signed-header", headerPath, "-validators", otherValsPath)
+	require.Equal(t, 1, code)
+	require.Equal(t, codeValidatorsMismatch, terr.Code)
+
+	// a header of the wrong height
+	sh, vals = signedHeader(t, height+2, appHash)
+	bz, err = cmtjson.Marshal(sh)
+	require.NoError(t, err)
+	headerPath = writeJSON(t, dir, "commit_next.json", bz)
+	bz, err = cmtjson.Marshal(vals.Validators)
+	require.NoError(t, err)
+	valsPath = writeJSON(t, dir, "validators_next.json", bz)
+	code, _, terr = runTool("verify", "-bundle", bundlePath, "-signed-header", headerPath, "-validators", valsPath)
+	require.Equal(t, 1, code)
+	require.Equal(t, codeHeightMismatch, terr.Code)
+}
+
+func TestConvert(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	require.Equal(t, 1, run([]string{"convert"}, bytes.NewReader([]byte(`{"result":{"response":{}}}`)), &stdout, &stderr))
+	require.Contains(t, stderr.String(), codeInvalidInput)
+
+	require.Equal(t, 2, run([]string{"-format", "yaml"}, bytes.NewReader(nil), &stdout, &stderr))
+}
@@ -0,0 +1,267 @@
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/gogoproto/jsonpb"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+
+	"github.com/maany-xyz/maany-provider/x/mintburn/client/cli"
+	mintburntypes "github.com/maany-xyz/maany-provider/x/mintburn/types"
+)
+
+// verifyResult is written to stdout when every mint of the bundle verifies.
+type verifyResult struct {
+	Verified  bool     `json:"verified"`
+	AppHash   string   `json:"appHash"`
+	Height    int64    `json:"height,omitempty"`
+	EscrowIDs []string `json:"escrowIds"`
+}
+
+func runVerify(args []string, stdout io.Writer) error {
+	fs := newFlagSet("verify")
+	bundlePath := fs.String("bundle", "", "genesismint bundle written by create-merkleproof-genesis.sh or launch-bundle")
+	appHashArg := fs.String("app-hash", "", "app hash to verify against, hex or base64")
+	headerPath := fs.String("signed-header", "", "signed header to take the app hash from, as returned by the /commit RPC")
+	validatorsPath := fs.String("validators", "", "validator set that signed the header, as returned by the /validators RPC")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if *bundlePath == "" {
+		return errorf(codeUsage, "-bundle is required")
+	}
+	if (*appHashArg == "") == (*headerPath == "") {
+		return errorf(codeUsage, "exactly one of -app-hash and -signed-header is required")
+	}
+	if (*headerPath == "") != (*validatorsPath == "") {
+		return errorf(codeUsage, "-signed-header and -validators go together")
+	}
+
+	bundle, err := loadBundle(*bundlePath)
+	if err != nil {
+		return err
+	}
+
+	res := verifyResult{Verified: true}
+	var appHash []byte
+	if *appHashArg != "" {
+		if appHash, err = decodeHash(*appHashArg); err != nil {
+			return errorf(codeInvalidInput, "invalid app hash: %v", err)
+		}
+	} else {
+		sh, err := loadSignedHeader(*headerPath)
+		if err != nil {
+			return err
+		}
+		vals, err := loadValidators(*validatorsPath)
+		if err != nil {
+			return err
+		}
+		if err := verifySignedHeader(sh, vals); err != nil {
+			return err
+		}
+		if sh.ChainID != bundle.Params.ProviderChainID {
+			return errorf(codeChainIDMismatch, "header is of chain %s, the bundle is of %s", sh.ChainID, bundle.Params.ProviderChainID)
+		}
+		appHash, res.Height = sh.AppHash, sh.Height
+	}
+
+	escrowIDs, err := verifyBundle(bundle, appHash, res.Height)
+	if err != nil {
+		return err
+	}
+	res.AppHash, res.EscrowIDs = strings.ToUpper(hex.EncodeToString(appHash)), escrowIDs
+
+	enc := json.NewEncoder(stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(res)
+}
+
+// verifyBundle verifies every mint of the bundle against appHash, the app hash
+// of the state each mint is proven at. headerHeight is the height of the
+// header appHash was taken from, 0 if it is not known.
+func verifyBundle(bundle cli.LaunchBundle, appHash []byte, headerHeight int64) ([]string, error) {
+	if len(bundle.Mints) == 0 {
+		return nil, errorf(codeInvalidBundle, "the bundle has no mints")
+	}
+
+	// the consumer trusts the root of the bundle, which must be the verified one
+	root := bundle.Params.GenesisTrustedRoot
+	if root.Hash != "" {
+		trusted, err := base64.StdEncoding.DecodeString(root.Hash)
+		if err != nil {
+			return nil, errorf(codeInvalidBundle, "invalid genesis trusted root hash: %v", err)
+		}
+		if !bytes.Equal(trusted, appHash) {
+			return nil, errorf(codeAppHashMismatch, "genesis trusted root hash is %X, verifying against %X", trusted, appHash)
+		}
+	}
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	escrowIDs := make([]string, 0, len(bundle.Mints))
+	for i, mint := range bundle.Mints {
+		if root.RevisionHeight != "" && root.RevisionHeight != strconv.FormatUint(mint.ProofHeightRevisionHeight, 10) {
+			return nil, errorf(codeHeightMismatch, "mint %d is proven at height %d, the genesis trusted root is at %s", i, mint.ProofHeightRevisionHeight, root.RevisionHeight)
+		}
+		// the app hash of the state at height h is in the header of block h+1
+		if headerHeight != 0 && int64(mint.ProofHeightRevisionHeight)+1 != headerHeight {
+			return nil, errorf(codeHeightMismatch, "mint %d is proven at height %d, needs the header of height %d, got %d", i, mint.ProofHeightRevisionHeight, mint.ProofHeightRevisionHeight+1, headerHeight)
+		}
+
+		if len(mint.KeyPath) != 2 || mint.KeyPath[0] != mintburntypes.StoreKey {
+			return nil, errorf(codeInvalidBundle, "mint %d: key path must be [%s, <hex key>], got %v", i, mintburntypes.StoreKey, mint.KeyPath)
+		}
+		key, err := hex.DecodeString(mint.KeyPath[1])
+		if err != nil {
+			return nil, errorf(codeInvalidBundle, "mint %d: invalid key: %v", i, err)
+		}
+		if !bytes.Equal(key, mintburntypes.EscrowKeyByID(mint.EscrowID)) {
+			return nil, errorf(codeValueMismatch, "mint %d: key %X is not the key of escrow %s", i, key, mint.EscrowID)
+		}
+		value, err := base64.StdEncoding.DecodeString(mint.Value)
+		if err != nil {
+			return nil, errorf(codeInvalidBundle, "mint %d: invalid value: %v", i, err)
+		}
+		var proof commitmenttypes.MerkleProof
+		if err := jsonpb.Unmarshal(bytes.NewReader(mint.MerkleProof), &proof); err != nil {
+			return nil, errorf(codeInvalidBundle, "mint %d: invalid merkle proof: %v", i, err)
+		}
+
+		escrow, err := cli.VerifyEscrowProof(cdc, proof, appHash, key, value)
+		if err != nil {
+			return nil, errorf(codeInvalidProof, "mint %d: %v", i, err)
+		}
+		if escrow.EscrowId != mint.EscrowID || escrow.Amount.Denom != mint.AmountDenom || escrow.Amount.Amount.String() != mint.AmountValue {
+			return nil, errorf(codeValueMismatch, "mint %d: proven escrow %s of %s does not match escrow %s of %s%s",
+				i, escrow.EscrowId, escrow.Amount, mint.EscrowID, mint.AmountValue, mint.AmountDenom)
+		}
+		escrowIDs = append(escrowIDs, escrow.EscrowId)
+	}
+	return escrowIDs, nil
+}
+
+// verifySignedHeader checks that vals is the validator set of the header and
+// that more than 2/3 of it signed the commit of the header. Whether vals is
+// the validator set of the provider is up to the caller to establish.
+func verifySignedHeader(sh *cmttypes.SignedHeader, vals *cmttypes.ValidatorSet) error {
+	if err := sh.ValidateBasic(sh.ChainID); err != nil {
+		return errorf(codeInvalidHeader, "%v", err)
+	}
+	if !bytes.Equal(vals.Hash(), sh.ValidatorsHash) {
+		return errorf(codeValidatorsMismatch, "validator set hash is %X, the header has %X", vals.Hash(), sh.ValidatorsHash)
+	}
+	if err := vals.VerifyCommitLight(sh.ChainID, sh.Commit.BlockID, sh.Height, sh.Commit); err != nil {
+		return errorf(codeInvalidCommit, "%v", err)
+	}
+	return nil
+}
+
+func loadBundle(path string) (cli.LaunchBundle, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return cli.LaunchBundle{}, errorf(codeInvalidInput, "%v", err)
+	}
+	var bundle cli.LaunchBundle
+	if err := json.Unmarshal(bz, &bundle); err != nil {
+		return cli.LaunchBundle{}, errorf(codeInvalidBundle, "%v", err)
+	}
+	return bundle, nil
+}
+
+// loadSignedHeader reads a signed header, either bare or wrapped in a /commit
+// RPC response.
+func loadSignedHeader(path string) (*cmttypes.SignedHeader, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errorf(codeInvalidInput, "%v", err)
+	}
+	var wrapped struct {
+		Result *struct {
+			SignedHeader json.RawMessage `json:"signed_header"`
+		} `json:"result"`
+		SignedHeader json.RawMessage `json:"signed_header"`
+	}
+	if err := json.Unmarshal(bz, &wrapped); err != nil {
+		return nil, errorf(codeInvalidHeader, "%v", err)
+	}
+	switch {
+	case wrapped.Result != nil && wrapped.Result.SignedHeader != nil:
+		bz = wrapped.Result.SignedHeader
+	case wrapped.SignedHeader != nil:
+		bz = wrapped.SignedHeader
+	}
+
+	var sh cmttypes.SignedHeader
+	if err := cmtjson.Unmarshal(bz, &sh); err != nil {
+		return nil, errorf(codeInvalidHeader, "%v", err)
+	}
+	if sh.Header == nil || sh.Commit == nil {
+		return nil, errorf(codeInvalidHeader, "%s has no header or commit", path)
+	}
+	return &sh, nil
+}
+
+// loadValidators reads a validator set, either a bare list of validators or a
+// complete, unpaginated /validators RPC response.
+func loadValidators(path string) (*cmttypes.ValidatorSet, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errorf(codeInvalidInput, "%v", err)
+	}
+	if trimmed := bytes.TrimSpace(bz); len(trimmed) == 0 || trimmed[0] != '[' {
+		var wrapped struct {
+			Result *json.RawMessage `json:"result"`
+		}
+		if err := json.Unmarshal(bz, &wrapped); err != nil {
+			return nil, errorf(codeInvalidInput, "%v", err)
+		}
+		if wrapped.Result != nil {
+			bz = *wrapped.Result
+		}
+		var page struct {
+			Validators json.RawMessage `json:"validators"`
+			Count      string          `json:"count"`
+			Total      string          `json:"total"`
+		}
+		if err := json.Unmarshal(bz, &page); err != nil {
+			return nil, errorf(codeInvalidInput, "%v", err)
+		}
+		if page.Count != page.Total {
+			return nil, errorf(codeInvalidInput, "%s has %s of %s validators, query /validators with a larger per_page", path, page.Count, page.Total)
+		}
+		bz = page.Validators
+	}
+
+	var vals []*cmttypes.Validator
+	if err := cmtjson.Unmarshal(bz, &vals); err != nil {
+		return nil, errorf(codeInvalidInput, "%v", err)
+	}
+	if len(vals) == 0 {
+		return nil, errorf(codeInvalidInput, "%s has no validators", path)
+	}
+	return cmttypes.NewValidatorSet(vals), nil
+}
+
+func decodeHash(s string) ([]byte, error) {
+	if bz, err := hex.DecodeString(s); err == nil {
+		return bz, nil
+	}
+	bz, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%q is neither hex nor base64", s)
+	}
+	return bz, nil
+}
@@ -37,4 +37,13 @@ var (
 
 	// ErrInvalidExpeditedProposal is used when an expedite proposal is submitted for an unsupported proposal type.
 	ErrInvalidExpeditedProposal = errorsmod.Register(codespace, 10, "unsupported expedited proposal type")
+
+	// ErrInvalidExtensionData is used when tx extension data breaks the limits of the metaprotocols registry.
+	ErrInvalidExtensionData = errorsmod.Register(codespace, 11, "invalid extension data")
+
+	// ErrEscrowRateLimited is used when a sender creates more mintburn escrows than the rate limit allows.
+	ErrEscrowRateLimited = errorsmod.Register(codespace, 12, "escrow rate limit exceeded")
+
+	// ErrPaused is used when a msg type or an IBC channel is paused by the circuit breaker.
+	ErrPaused = errorsmod.Register(codespace, 13, "paused by the circuit breaker")
 )
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/maany-xyz/maany-provider/x/blockrewards/types"
+)
+
+// =========================
+// Claim-based payouts
+// Per validator: AccruedRewardsPrefix + valAddr -> AccruedRewards
+// Total:         TotalAccruedKey               -> AccruedRewards (sum, no validator)
+// The coins stay in the blockrewards module account until they are claimed.
+// =========================
+
+// AccrueReward credits amount to the validator's claimable balance. It fails
+// if the module account does not hold enough unreserved funds to back it.
+func (k Keeper) AccrueReward(ctx sdk.Context, valAddr sdk.ValAddress, amount sdk.Coins) error {
+	if unreserved := k.unreservedBalance(ctx); !unreserved.IsAllGTE(amount) {
+		return fmt.Errorf("insufficient unreserved module balance: unreserved %s, reward %s", unreserved, amount)
+	}
+	total := k.GetTotalAccrued(ctx)
+
+	accrued := k.GetAccruedRewards(ctx, valAddr)
+	k.setAccruedRewards(ctx, valAddr, accrued.Add(amount...))
+	k.setTotalAccrued(ctx, total.Add(amount...))
+	return nil
+}
+
+// ClaimRewards pays out everything the validator has accrued to its operator
+// account. When compound is set, the bond denom part of the payout is then
+// delegated back to the validator from the operator account.
+func (k Keeper) ClaimRewards(ctx sdk.Context, valAddr sdk.ValAddress, compound bool) (claimed, compounded sdk.Coins, err error) {
+	claimed = k.GetAccruedRewards(ctx, valAddr)
+	if claimed.IsZero() {
+		return sdk.NewCoins(), sdk.NewCoins(), nil
+	}
+
+	recipient := sdk.AccAddress(valAddr)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, claimed); err != nil {
+		return nil, nil, fmt.Errorf("failed to send claimed block rewards: %w", err)
+	}
+	k.setAccruedRewards(ctx, valAddr, sdk.NewCoins())
+	total, _ := k.GetTotalAccrued(ctx).SafeSub(claimed...)
+	k.setTotalAccrued(ctx, total)
+
+	compounded = sdk.NewCoins()
+	if compound {
+		bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		if amt := claimed.AmountOf(bondDenom); amt.IsPositive() {
+			coin := sdk.NewCoin(bondDenom, amt)
+			msgServer := stakingkeeper.NewMsgServerImpl(&k.stakingKeeper)
+			if _, err := msgServer.Delegate(ctx, stakingtypes.NewMsgDelegate(recipient.String(), valAddr.String(), coin)); err != nil {
+				return nil, nil, fmt.Errorf("failed to compound block rewards: %w", err)
+			}
+			compounded = sdk.NewCoins(coin)
+		}
+	}
+
+	return claimed, compounded, ctx.EventManager().EmitTypedEvent(&types.EventBlockRewardsClaimed{
+		ValidatorAddress: valAddr.String(),
+		Recipient:        recipient.String(),
+		Amount:           claimed,
+		Compounded:       compounded,
+	})
+}
+
+// GetAccruedRewards returns the rewards the validator can currently claim.
+func (k Keeper) GetAccruedRewards(ctx sdk.Context, valAddr sdk.ValAddress) sdk.Coins {
+	bz := ctx.KVStore(k.storeKey).Get(types.AccruedRewardsKey(valAddr))
+	if bz == nil {
+		return sdk.NewCoins()
+	}
+	var r types.AccruedRewards
+	k.cdc.MustUnmarshal(bz, &r)
+	return r.Rewards
+}
+
+func (k Keeper) setAccruedRewards(ctx sdk.Context, valAddr sdk.ValAddress, rewards sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	if rewards.IsZero() {
+		store.Delete(types.AccruedRewardsKey(valAddr))
+		return
+	}
+	r := types.AccruedRewards{ValidatorAddress: valAddr.String(), Rewards: rewards}
+	store.Set(types.AccruedRewardsKey(valAddr), k.cdc.MustMarshal(&r))
+}
+
+// GetTotalAccrued returns the sum of all unclaimed rewards, i.e. the part of
+// the module balance that is reserved for claims.
+func (k Keeper) GetTotalAccrued(ctx sdk.Context) sdk.Coins {
+	bz := ctx.KVStore(k.storeKey).Get(types.TotalAccruedKey)
+	if bz == nil {
+		return sdk.NewCoins()
+	}
+	var r types.AccruedRewards
+	k.cdc.MustUnmarshal(bz, &r)
+	return r.Rewards
+}
+
+func (k Keeper) setTotalAccrued(ctx sdk.Context, total sdk.Coins) {
+	r := types.AccruedRewards{Rewards: total}
+	ctx.KVStore(k.storeKey).Set(types.TotalAccruedKey, k.cdc.MustMarshal(&r))
+}
+
+// IterateAccruedRewards walks all unclaimed validator balances.
+func (k Keeper) IterateAccruedRewards(ctx sdk.Context, cb func(r types.AccruedRewards) (stop bool)) {
+	ps := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccruedRewardsPrefix)
+	it := ps.Iterator(nil, nil)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var r types.AccruedRewards
+		k.cdc.MustUnmarshal(it.Value(), &r)
+		if cb(r) {
+			return
+		}
+	}
+}
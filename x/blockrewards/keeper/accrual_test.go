@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-provider/app/helpers"
+	"github.com/maany-xyz/maany-provider/x/blockrewards/keeper"
+	"github.com/maany-xyz/maany-provider/x/blockrewards/types"
+)
+
+func TestAccrueAndClaimRewards(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+	k := gaiaApp.BlockRewardsKeeper
+
+	validators, err := gaiaApp.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	valAddr, err := sdk.ValAddressFromBech32(validators[0].GetOperator())
+	require.NoError(t, err)
+	operator := sdk.AccAddress(valAddr)
+
+	bondDenom, err := gaiaApp.StakingKeeper.BondDenom(ctx)
+	require.NoError(t, err)
+	reward := sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1_000_000)))
+
+	// accruing without funds backing the reward fails
+	require.Error(t, k.AccrueReward(ctx, valAddr, reward))
+
+	require.NoError(t, gaiaApp.BankKeeper.MintCoins(ctx, types.ModuleName, reward.Add(reward...)))
+	require.NoError(t, k.AccrueReward(ctx, valAddr, reward))
+	require.NoError(t, k.AccrueReward(ctx, valAddr, reward))
+	// everything in the module is reserved now
+	require.Error(t, k.AccrueReward(ctx, valAddr, reward))
+	require.Equal(t, reward.Add(reward...), k.GetAccruedRewards(ctx, valAddr))
+	require.Equal(t, reward.Add(reward...), k.GetTotalAccrued(ctx))
+
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	// only the operator account may claim
+	_, err = msgServer.ClaimBlockRewards(ctx, &types.MsgClaimBlockRewards{
+		Claimer:          sdk.AccAddress([]byte("someone_else________")).String(),
+		ValidatorAddress: valAddr.String(),
+	})
+	require.Error(t, err)
+
+	sharesBefore := validators[0].DelegatorShares
+	resp, err := msgServer.ClaimBlockRewards(ctx, &types.MsgClaimBlockRewards{
+		Claimer:          operator.String(),
+		ValidatorAddress: valAddr.String(),
+		Compound:         true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, reward.Add(reward...), resp.Amount)
+	require.Equal(t, reward.Add(reward...), resp.Compounded)
+
+	require.True(t, k.GetAccruedRewards(ctx, valAddr).IsZero())
+	require.True(t, k.GetTotalAccrued(ctx).IsZero())
+
+	validator, err := gaiaApp.StakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	require.True(t, validator.DelegatorShares.GT(sharesBefore))
+
+	// a second claim is a no-op
+	resp, err = msgServer.ClaimBlockRewards(ctx, &types.MsgClaimBlockRewards{
+		Claimer:          operator.String(),
+		ValidatorAddress: valAddr.String(),
+	})
+	require.NoError(t, err)
+	require.True(t, resp.Amount.IsZero())
+}
+
+func TestUpdateParamsAuthority(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+	k := gaiaApp.BlockRewardsKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	params := types.DefaultParams()
+	params.PayoutMode = types.PAYOUT_MODE_PUSH
+
+	_, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: sdk.AccAddress([]byte("not_the_authority___")).String(),
+		Params:    params,
+	})
+	require.Error(t, err)
+
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: k.GetAuthority(),
+		Params:    params,
+	})
+	require.NoError(t, err)
+
+	got, err := k.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.PAYOUT_MODE_PUSH, got.PayoutMode)
+}
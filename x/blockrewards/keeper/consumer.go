@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	providertypes "github.com/cosmos/interchain-security/v5/x/ccv/provider/types"
+
+	"github.com/maany-xyz/maany-provider/x/blockrewards/types"
+)
+
+// ShareWithConsumerValidators routes the consumer share of a block reward to
+// the ICS consumer rewards pool and returns what is left for the proposer.
+//
+// The share is split between the registered consumer chains by the total
+// voting power of their opted-in validator sets and added to each chain's
+// rewards allocation, which the provider module pays out to the chain's
+// validators in its BeginBlocker. If no consumer chain has validators, the
+// whole reward is left for the proposer.
+func (k Keeper) ShareWithConsumerValidators(ctx sdk.Context, params types.Params, reward sdk.Coins) (sdk.Coins, error) {
+	consumerShare, proposerShare := params.ConsumerShare(reward)
+	if consumerShare.IsZero() {
+		return reward, nil
+	}
+
+	var chainIDs []string
+	var powers []int64
+	totalPower := int64(0)
+	for _, chainID := range k.providerKeeper.GetAllRegisteredConsumerChainIDs(ctx) {
+		power := k.providerKeeper.ComputeConsumerTotalVotingPower(ctx, chainID)
+		if power <= 0 {
+			continue
+		}
+		chainIDs = append(chainIDs, chainID)
+		powers = append(powers, power)
+		totalPower += power
+	}
+	if totalPower == 0 {
+		return reward, nil
+	}
+
+	if unreserved := k.unreservedBalance(ctx); !unreserved.IsAllGTE(consumerShare) {
+		return nil, fmt.Errorf("insufficient unreserved module balance: unreserved %s, consumer share %s", unreserved, consumerShare)
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, providertypes.ConsumerRewardsPool, consumerShare); err != nil {
+		return nil, fmt.Errorf("failed to send consumer share to the consumer rewards pool: %w", err)
+	}
+
+	// the last chain gets the rounding remainder so nothing is left unallocated
+	remaining := consumerShare
+	for i, chainID := range chainIDs {
+		part := remaining
+		if i < len(chainIDs)-1 {
+			part = sdk.NewCoins()
+			weight := math.LegacyNewDec(powers[i]).QuoInt64(totalPower)
+			for _, c := range consumerShare {
+				part = part.Add(sdk.NewCoin(c.Denom, weight.MulInt(c.Amount).TruncateInt()))
+			}
+		}
+		remaining = remaining.Sub(part...)
+		if part.IsZero() {
+			continue
+		}
+
+		alloc := k.providerKeeper.GetConsumerRewardsAllocation(ctx, chainID)
+		alloc.Rewards = alloc.Rewards.Add(sdk.NewDecCoinsFromCoins(part...)...)
+		k.providerKeeper.SetConsumerRewardsAllocation(ctx, chainID, alloc)
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventConsumerRewardsShared{
+			ChainId: chainID,
+			Amount:  part,
+			Height:  ctx.BlockHeight(),
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return proposerShare, nil
+}
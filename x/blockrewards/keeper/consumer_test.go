@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	providertypes "github.com/cosmos/interchain-security/v5/x/ccv/provider/types"
+
+	"github.com/maany-xyz/maany-provider/app/helpers"
+	"github.com/maany-xyz/maany-provider/x/blockrewards/types"
+)
+
+func TestShareWithConsumerValidators(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+	k := gaiaApp.BlockRewardsKeeper
+	pk := gaiaApp.ProviderKeeper
+
+	validators, err := gaiaApp.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	valAddr, err := sdk.ValAddressFromBech32(validators[0].GetOperator())
+	require.NoError(t, err)
+	consAddr, err := validators[0].GetConsAddr()
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.ConsumerRewardShare = math.LegacyNewDecWithPrec(5, 1)
+	require.NoError(t, k.SetParams(ctx, params))
+
+	reward := sdk.NewCoins(params.BlockRewardAmount)
+	require.NoError(t, gaiaApp.BankKeeper.MintCoins(ctx, types.ModuleName, reward.Add(reward...)))
+	// consumer validators only count once they have validated long enough
+	// to be eligible for consumer rewards
+	ctx = ctx.WithBlockHeight(pk.GetNumberOfEpochsToStartReceivingRewards(ctx)*pk.GetBlocksPerEpoch(ctx) + 1).WithProposer(consAddr)
+
+	// without consumer validators the proposer keeps the whole reward
+	require.NoError(t, k.DistributeRewards(ctx, ctx, reward))
+	require.Equal(t, reward, k.GetAccruedRewards(ctx, valAddr))
+
+	pk.SetConsumerClientId(ctx, "consumer-1", "07-tendermint-0")
+	pk.SetConsumerClientId(ctx, "consumer-2", "07-tendermint-1")
+	pk.SetConsumerValidator(ctx, "consumer-1", providertypes.ConsumerValidator{ProviderConsAddr: consAddr, Power: 30})
+	pk.SetConsumerValidator(ctx, "consumer-2", providertypes.ConsumerValidator{ProviderConsAddr: consAddr, Power: 10})
+
+	require.NoError(t, k.DistributeRewards(ctx, ctx, reward))
+
+	denom := params.BlockRewardAmount.Denom
+	half := params.BlockRewardAmount.Amount.QuoRaw(2)
+	require.Equal(t, reward.Add(sdk.NewCoin(denom, half)), k.GetAccruedRewards(ctx, valAddr))
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(denom, half)), pk.GetConsumerRewardsPool(ctx))
+
+	// the consumer share is split by the voting power of each chain's validator set
+	require.Equal(t,
+		sdk.NewDecCoins(sdk.NewDecCoin(denom, half.MulRaw(3).QuoRaw(4))),
+		pk.GetConsumerRewardsAllocation(ctx, "consumer-1").Rewards)
+	require.Equal(t,
+		sdk.NewDecCoins(sdk.NewDecCoin(denom, half.QuoRaw(4))),
+		pk.GetConsumerRewardsAllocation(ctx, "consumer-2").Rewards)
+}
+
+func TestConsumerShareCommittedWithProposerReward(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+	k := gaiaApp.BlockRewardsKeeper
+	pk := gaiaApp.ProviderKeeper
+
+	validators, err := gaiaApp.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	consAddr, err := validators[0].GetConsAddr()
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.ConsumerRewardShare = math.LegacyNewDecWithPrec(5, 1)
+	params.WithholdingPeriod = 10
+	params.RequiredConsumerChains = []string{"consumer-2"}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	reward := sdk.NewCoins(params.BlockRewardAmount)
+	require.NoError(t, gaiaApp.BankKeeper.MintCoins(ctx, types.ModuleName, reward))
+	ctx = ctx.WithBlockHeight(pk.GetNumberOfEpochsToStartReceivingRewards(ctx)*pk.GetBlocksPerEpoch(ctx) + 1).WithProposer(consAddr)
+
+	pk.SetConsumerClientId(ctx, "consumer-1", "07-tendermint-0")
+	pk.SetConsumerValidator(ctx, "consumer-1", providertypes.ConsumerValidator{ProviderConsAddr: consAddr, Power: 10})
+
+	// an ineligible proposer doesn't pay the consumer share either
+	require.NoError(t, k.DistributeRewards(ctx, ctx, reward))
+	require.True(t, pk.GetConsumerRewardsPool(ctx).IsZero())
+	require.True(t, k.GetTotalWithheld(ctx).IsZero())
+
+	params.RequiredConsumerChains = nil
+	require.NoError(t, k.SetParams(ctx, params))
+
+	denom := params.BlockRewardAmount.Denom
+	half := sdk.NewCoins(sdk.NewCoin(denom, params.BlockRewardAmount.Amount.QuoRaw(2)))
+	require.NoError(t, k.DistributeRewards(ctx, ctx, reward))
+	require.Equal(t, half, pk.GetConsumerRewardsPool(ctx))
+	require.Equal(t, half, k.GetTotalWithheld(ctx))
+
+	// the rest of the module balance is withheld, a failed withholding
+	// rolls the consumer share back
+	require.Error(t, k.DistributeRewards(ctx, ctx, reward))
+	require.Equal(t, half, pk.GetConsumerRewardsPool(ctx))
+	require.Equal(t, half, k.GetTotalWithheld(ctx))
+	require.Equal(t, half, gaiaApp.BankKeeper.GetAllBalances(ctx, gaiaApp.AccountKeeper.GetModuleAddress(types.ModuleName)))
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	providertypes "github.com/cosmos/interchain-security/v5/x/ccv/provider/types"
+
+	"github.com/maany-xyz/maany-provider/x/blockrewards/types"
+)
+
+// ineligibilityReason returns why the proposer must not be rewarded for the
+// current block, or an empty string if it is eligible.
+func (k Keeper) ineligibilityReason(ctx sdk.Context, params types.Params, validator stakingtypes.ValidatorI, consAddr sdk.ConsAddress) string {
+	switch {
+	case validator.IsJailed():
+		return "jailed"
+	case !validator.IsBonded():
+		return "not bonded"
+	case k.slashingKeeper.IsTombstoned(ctx, consAddr):
+		return "tombstoned"
+	}
+
+	providerAddr := providertypes.NewProviderConsAddress(consAddr)
+	for _, chainID := range params.RequiredConsumerChains {
+		if !k.providerKeeper.IsOptedIn(ctx, chainID, providerAddr) && !k.providerKeeper.IsConsumerValidator(ctx, chainID, providerAddr) {
+			return "not validating consumer chain " + chainID
+		}
+	}
+	return ""
+}
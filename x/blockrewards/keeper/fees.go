@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/maany-xyz/maany-provider/x/blockrewards/types"
+)
+
+// RouteFees moves the governance-set shares of fees collected by the collector
+// module account to the blockrewards module account and burns the burn share.
+func (k Keeper) RouteFees(ctx sdk.Context, collector string, collected sdk.Coins) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	redirect, burn := params.FeeShares(collected)
+	routed := redirect.Add(burn...)
+	if routed.IsZero() {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, collector, types.ModuleName, routed); err != nil {
+		return err
+	}
+	if !burn.IsZero() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burn); err != nil {
+			return err
+		}
+	}
+
+	totals := k.GetFeeTotals(ctx)
+	totals.Redirected = totals.Redirected.Add(redirect...)
+	totals.Burned = totals.Burned.Add(burn...)
+	k.setFeeTotals(ctx, totals)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventFeesRouted{
+		Redirected: redirect,
+		Burned:     burn,
+	})
+}
+
+// FeeCollectorBalance returns the fee collector balance of denom.
+func (k Keeper) FeeCollectorBalance(ctx sdk.Context, denom string) sdk.Coin {
+	return k.bankKeeper.GetBalance(ctx, k.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName), denom)
+}
+
+// GetFeeTotals returns the cumulative fees redirected and burned by RouteFees.
+func (k Keeper) GetFeeTotals(ctx sdk.Context) types.FeeTotals {
+	bz := ctx.KVStore(k.storeKey).Get(types.FeeTotalsKey)
+	if bz == nil {
+		return types.FeeTotals{Redirected: sdk.NewCoins(), Burned: sdk.NewCoins()}
+	}
+	var totals types.FeeTotals
+	k.cdc.MustUnmarshal(bz, &totals)
+	return totals
+}
+
+func (k Keeper) setFeeTotals(ctx sdk.Context, totals types.FeeTotals) {
+	ctx.KVStore(k.storeKey).Set(types.FeeTotalsKey, k.cdc.MustMarshal(&totals))
+}
@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/maany-xyz/maany-provider/app/helpers"
+	"github.com/maany-xyz/maany-provider/x/blockrewards/types"
+)
+
+func TestRouteFees(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+	k := gaiaApp.BlockRewardsKeeper
+
+	params := types.DefaultParams()
+	params.FeeRedirectShare = math.LegacyNewDecWithPrec(4, 1)
+	params.FeeBurnShare = math.LegacyNewDecWithPrec(1, 1)
+	require.NoError(t, k.SetParams(ctx, params))
+	denom := params.BlockRewardAmount.Denom
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))
+	require.NoError(t, gaiaApp.BankKeeper.MintCoins(ctx, types.ModuleName, fees))
+	require.NoError(t, gaiaApp.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, fees))
+
+	moduleAddr := gaiaApp.AccountKeeper.GetModuleAddress(types.ModuleName)
+	collectorBefore := k.FeeCollectorBalance(ctx, denom)
+	moduleBefore := gaiaApp.BankKeeper.GetBalance(ctx, moduleAddr, denom)
+	supplyBefore := gaiaApp.BankKeeper.GetSupply(ctx, denom)
+
+	require.NoError(t, k.RouteFees(ctx, authtypes.FeeCollectorName, fees))
+
+	require.Equal(t, collectorBefore.SubAmount(math.NewInt(500)), k.FeeCollectorBalance(ctx, denom))
+	require.Equal(t, moduleBefore.AddAmount(math.NewInt(400)), gaiaApp.BankKeeper.GetBalance(ctx, moduleAddr, denom))
+	require.Equal(t, supplyBefore.SubAmount(math.NewInt(100)), gaiaApp.BankKeeper.GetSupply(ctx, denom))
+
+	require.NoError(t, k.RouteFees(ctx, authtypes.FeeCollectorName, fees))
+	totals := k.GetFeeTotals(ctx)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom, 800)), totals.Redirected)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom, 200)), totals.Burned)
+
+	// totals are part of the exported genesis
+	genState := k.ExportGenesis(ctx)
+	require.Equal(t, totals, genState.FeeTotals)
+}
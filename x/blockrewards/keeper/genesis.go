@@ -16,6 +16,41 @@ func (k Keeper) InitGenesis(ctx context.Context, genState *types.GenesisState) [
     if err := k.SetParams(sdkCtx, genState.Params); err != nil {
         panic(fmt.Sprintf("failed to set params in InitGenesis: %v", err))
     }
+
+    // Restore the rewards ledger
+    for _, r := range genState.ValidatorRewards {
+        valAddr, err := sdk.ValAddressFromBech32(r.ValidatorAddress)
+        if err != nil {
+            panic(fmt.Sprintf("invalid validator address in rewards ledger: %v", err))
+        }
+        k.SetValidatorRewards(sdkCtx, valAddr, r)
+    }
+    for _, r := range genState.EpochRewards {
+        k.SetEpochRewards(sdkCtx, r)
+    }
+
+    // Restore unclaimed rewards and their reserved total
+    total := sdk.NewCoins()
+    for _, r := range genState.AccruedRewards {
+        valAddr, err := sdk.ValAddressFromBech32(r.ValidatorAddress)
+        if err != nil {
+            panic(fmt.Sprintf("invalid validator address in accrued rewards: %v", err))
+        }
+        k.setAccruedRewards(sdkCtx, valAddr, r.Rewards)
+        total = total.Add(r.Rewards...)
+    }
+    k.setTotalAccrued(sdkCtx, total)
+
+    // Restore withheld rewards and their reserved total
+    totalWithheld := sdk.NewCoins()
+    for _, w := range genState.WithheldRewards {
+        k.SetWithheldReward(sdkCtx, w)
+        totalWithheld = totalWithheld.Add(w.Rewards...)
+    }
+    k.setTotalWithheld(sdkCtx, totalWithheld)
+
+    k.setFeeTotals(sdkCtx, genState.FeeTotals)
+
     // Return validator updates if this module affects staking/validators
     return []abci.ValidatorUpdate{}
 }
@@ -27,7 +62,36 @@ func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
     if err != nil {
         return nil
     }
+
+    validatorRewards := []types.ValidatorRewardRecord{}
+    k.IterateValidatorRewards(sdkCtx, func(r types.ValidatorRewardRecord) bool {
+        validatorRewards = append(validatorRewards, r)
+        return false
+    })
+    epochRewards := []types.EpochValidatorRewards{}
+    k.IterateEpochRewards(sdkCtx, func(r types.EpochValidatorRewards) bool {
+        epochRewards = append(epochRewards, r)
+        return false
+    })
+
+    accruedRewards := []types.AccruedRewards{}
+    k.IterateAccruedRewards(sdkCtx, func(r types.AccruedRewards) bool {
+        accruedRewards = append(accruedRewards, r)
+        return false
+    })
+
+    withheldRewards := []types.WithheldReward{}
+    k.IterateWithheldRewards(sdkCtx, func(w types.WithheldReward) bool {
+        withheldRewards = append(withheldRewards, w)
+        return false
+    })
+
     return &types.GenesisState{
-        Params: params,
+        Params:           params,
+        ValidatorRewards: validatorRewards,
+        EpochRewards:     epochRewards,
+        AccruedRewards:   accruedRewards,
+        WithheldRewards:  withheldRewards,
+        FeeTotals:        k.GetFeeTotals(sdkCtx),
     }
-}
\ No newline at end of file
+}
@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/maany-xyz/maany-provider/x/blockrewards/types"
+)
+
+// defaultTopEarnersLimit is used when a TopEarners request sets no limit.
+const defaultTopEarnersLimit = 10
+
+// queryServer implements types.QueryServer.
+type queryServer struct {
+	Keeper
+}
+
+// Ensure queryServer implements the generated QueryServer.
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServer returns a types.QueryServer backed by the given keeper.
+func NewQueryServer(k Keeper) types.QueryServer {
+	return queryServer{k}
+}
+
+// Params returns the module parameters.
+func (q queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := q.GetParams(sdk.UnwrapSDKContext(ctx))
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// ValidatorRewards returns the cumulative and per-epoch ledger of a validator.
+func (q queryServer) ValidatorRewards(ctx context.Context, req *types.QueryValidatorRewardsRequest) (*types.QueryValidatorRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address: %v", err)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	params, err := q.GetParams(sdkCtx)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	total, found := q.GetValidatorRewards(sdkCtx, valAddr)
+	if !found {
+		total = types.ValidatorRewardRecord{ValidatorAddress: req.ValidatorAddress}
+	}
+
+	epochs := make([]types.EpochValidatorRewards, 0)
+	q.IterateEpochRewards(sdkCtx, func(r types.EpochValidatorRewards) bool {
+		if r.ValidatorAddress == req.ValidatorAddress {
+			epochs = append(epochs, r)
+		}
+		return false
+	})
+
+	withheld := make([]types.WithheldReward, 0)
+	q.IterateWithheldRewards(sdkCtx, func(w types.WithheldReward) bool {
+		if w.ValidatorAddress == req.ValidatorAddress {
+			withheld = append(withheld, w)
+		}
+		return false
+	})
+
+	return &types.QueryValidatorRewardsResponse{
+		Total:        total,
+		Epochs:       epochs,
+		CurrentEpoch: params.Epoch(sdkCtx.BlockHeight()),
+		Accrued: types.AccruedRewards{
+			ValidatorAddress: req.ValidatorAddress,
+			Rewards:          q.GetAccruedRewards(sdkCtx, valAddr),
+		},
+		Withheld: withheld,
+	}, nil
+}
+
+// TopEarners ranks validators by block rewards earned in an epoch or overall.
+func (q queryServer) TopEarners(ctx context.Context, req *types.QueryTopEarnersRequest) (*types.QueryTopEarnersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	params, err := q.GetParams(sdkCtx)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = defaultTopEarnersLimit
+	}
+
+	entries := make([]types.EpochValidatorRewards, 0)
+	epoch := req.Epoch
+	if req.AllTime {
+		epoch = 0
+		q.IterateValidatorRewards(sdkCtx, func(r types.ValidatorRewardRecord) bool {
+			entries = append(entries, types.EpochValidatorRewards{
+				ValidatorAddress: r.ValidatorAddress,
+				Rewards:          r.TotalRewards,
+				BlocksRewarded:   r.BlocksRewarded,
+			})
+			return false
+		})
+	} else {
+		if epoch == 0 {
+			epoch = params.Epoch(sdkCtx.BlockHeight())
+		}
+		q.IterateEpochRewardsForEpoch(sdkCtx, epoch, func(r types.EpochValidatorRewards) bool {
+			entries = append(entries, r)
+			return false
+		})
+	}
+
+	return &types.QueryTopEarnersResponse{
+		Epoch:   epoch,
+		Earners: TopEarners(entries, params.BlockRewardAmount.Denom, limit),
+	}, nil
+}
+
+// FeeTotals returns the cumulative tx fees redirected to the module and burned.
+func (q queryServer) FeeTotals(ctx context.Context, _ *types.QueryFeeTotalsRequest) (*types.QueryFeeTotalsResponse, error) {
+	return &types.QueryFeeTotalsResponse{FeeTotals: q.GetFeeTotals(sdk.UnwrapSDKContext(ctx))}, nil
+}
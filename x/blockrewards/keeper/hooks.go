@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Hooks wraps the blockrewards keeper to claw back withheld rewards of
+// slashed validators.
+type Hooks struct {
+	k *Keeper
+}
+
+var _ stakingtypes.StakingHooks = Hooks{}
+
+// Hooks returns the staking hooks of the blockrewards keeper. The keeper is
+// taken by reference so the hooks can be registered before it is built.
+func (k *Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// BeforeValidatorSlashed claws back the rewards still withheld for the validator.
+func (h Hooks) BeforeValidatorSlashed(ctx context.Context, valAddr sdk.ValAddress, _ math.LegacyDec) error {
+	_, err := h.k.ClawbackWithheldRewards(sdk.UnwrapSDKContext(ctx), valAddr)
+	return err
+}
+
+func (h Hooks) AfterValidatorCreated(_ context.Context, _ sdk.ValAddress) error { return nil }
+
+func (h Hooks) BeforeValidatorModified(_ context.Context, _ sdk.ValAddress) error { return nil }
+
+func (h Hooks) AfterValidatorRemoved(_ context.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterValidatorBonded(_ context.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterValidatorBeginUnbonding(_ context.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeDelegationCreated(_ context.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeDelegationSharesModified(_ context.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeDelegationRemoved(_ context.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterDelegationModified(_ context.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterUnbondingInitiated(_ context.Context, _ uint64) error { return nil }
+
+func (h Hooks) BeforeTokenizeShareRecordRemoved(_ context.Context, _ uint64) error { return nil }
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"encoding/binary"
 	"encoding/hex"
 	"fmt"
 
@@ -19,28 +20,50 @@ import (
 type Keeper struct {
     cdc           codec.BinaryCodec
     storeKey      storetypes.StoreKey
+    tStoreKey     storetypes.StoreKey
     bankKeeper    bankKeeper.Keeper
     stakingKeeper stakingKeeper.Keeper
     accountKeeper accountKeeper.AccountKeeper
+    slashingKeeper types.SlashingKeeper
+    distrKeeper    types.DistributionKeeper
+    providerKeeper types.ProviderKeeper
+
+    // the address capable of executing a MsgUpdateParams message, typically x/gov
+    authority     string
 }
 
 // NewKeeper creates a new blockrewards Keeper instance
 func NewKeeper (
     cdc           codec.BinaryCodec,
     storeKey      storetypes.StoreKey,
+    tStoreKey     storetypes.StoreKey,
     bankKeeper    bankKeeper.Keeper,
     stakingKeeper stakingKeeper.Keeper,
     accountKeeper accountKeeper.AccountKeeper,
+    slashingKeeper types.SlashingKeeper,
+    distrKeeper    types.DistributionKeeper,
+    providerKeeper types.ProviderKeeper,
+    authority     string,
 ) Keeper {
     return Keeper{
         cdc:           cdc,
         storeKey:      storeKey,
+        tStoreKey:     tStoreKey,
         bankKeeper:    bankKeeper,
         stakingKeeper: stakingKeeper,
         accountKeeper: accountKeeper,
+        slashingKeeper: slashingKeeper,
+        distrKeeper:    distrKeeper,
+        providerKeeper: providerKeeper,
+        authority:     authority,
     }
 }
 
+// GetAuthority returns the module authority.
+func (k Keeper) GetAuthority() string {
+    return k.authority
+}
+
 func (k Keeper) DistributeRewards(sdkCtx sdk.Context, ctx context.Context, rewardAmount sdk.Coins) error {
     proposerAddress := sdkCtx.BlockHeader().ProposerAddress
     if len(proposerAddress) == 0 {
@@ -65,20 +88,101 @@ func (k Keeper) DistributeRewards(sdkCtx sdk.Context, ctx context.Context, rewar
     accountAddress := sdk.AccAddress(proposerAccAddress)
     sdkCtx.Logger().Info("Proposer Account Address", "account_address", accountAddress.String())
 
+    params, err := k.GetParams(sdkCtx)
+    if err != nil {
+        return err
+    }
+
+    if reason := k.ineligibilityReason(sdkCtx, params, proposerValidator, sdk.ConsAddress(proposerAddress)); reason != "" {
+        sdkCtx.Logger().Info("Proposer not eligible for block reward", "validator", proposerAccAddress.String(), "reason", reason)
+        return sdkCtx.EventManager().EmitTypedEvent(&types.EventBlockRewardSkipped{
+            ValidatorAddress: proposerAccAddress.String(),
+            Reason:           reason,
+            Height:           sdkCtx.BlockHeight(),
+        })
+    }
+
+    // The consumer share and the proposer reward are committed together, a
+    // failed payout must not leave the consumer share routed
+    cacheCtx, write := sdkCtx.CacheContext()
+    if err := k.rewardProposer(cacheCtx, params, proposerAccAddress, rewardAmount); err != nil {
+        return err
+    }
+    write()
+    return nil
+}
+
+// rewardProposer routes the consumer chain validators' share of the block
+// reward and then withholds or pays out the rest to the proposer.
+func (k Keeper) rewardProposer(sdkCtx sdk.Context, params types.Params, valAddr sdk.ValAddress, rewardAmount sdk.Coins) error {
+    rewardAmount, err := k.ShareWithConsumerValidators(sdkCtx, params, rewardAmount)
+    if err != nil {
+        sdkCtx.Logger().Error("Failed to share block reward with consumer validators", "error", err)
+        return fmt.Errorf("failed to share block reward with consumer validators: %w", err)
+    }
+    if rewardAmount.IsZero() {
+        return nil
+    }
+
+    // Hold the reward back so it can still be clawed back on a slash
+    if params.WithholdingPeriod > 0 {
+        if err := k.WithholdReward(sdkCtx, valAddr, rewardAmount, sdkCtx.BlockHeight()+int64(params.WithholdingPeriod)); err != nil {
+            sdkCtx.Logger().Error("Failed to withhold block rewards", "error", err, "validator", valAddr.String(), "amount", rewardAmount.String())
+            return fmt.Errorf("failed to withhold block rewards: %w", err)
+        }
+        return nil
+    }
+
+    return k.payout(sdkCtx, params, valAddr, rewardAmount)
+}
+
+// payout hands an earned reward to the validator according to the payout mode.
+// The coins must not already be reserved for another validator.
+func (k Keeper) payout(sdkCtx sdk.Context, params types.Params, valAddr sdk.ValAddress, rewardAmount sdk.Coins) error {
+    accountAddress := sdk.AccAddress(valAddr)
+
+    // Claim mode: credit the validator and leave the coins in the module
+    if params.PayoutMode == types.PAYOUT_MODE_CLAIM {
+        if err := k.AccrueReward(sdkCtx, valAddr, rewardAmount); err != nil {
+            sdkCtx.Logger().Error("Failed to accrue block rewards", "error", err, "validator", valAddr.String(), "amount", rewardAmount.String())
+            return fmt.Errorf("failed to accrue block rewards: %w", err)
+        }
+        sdkCtx.Logger().Debug("Accrued block reward", "validator", valAddr.String(), "amount", rewardAmount.String())
+        return k.RecordReward(sdkCtx, valAddr, accountAddress, rewardAmount, true)
+    }
+
+    // Coins reserved for unclaimed rewards must not be pushed to the proposer
+    if !k.unreservedBalance(sdkCtx).IsAllGTE(rewardAmount) {
+        return fmt.Errorf("insufficient unreserved module balance for block reward %s", rewardAmount)
+    }
+
     // Send rewards
-    err2 := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, "blockrewards", accountAddress, rewardAmount)
+    err2 := k.bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, "blockrewards", accountAddress, rewardAmount)
     if err2 != nil {
         sdkCtx.Logger().Error("Failed to send block rewards", "error", err2, "proposer", accountAddress.String(), "amount", rewardAmount.String())
         return fmt.Errorf("failed to send block rewards: %w", err2)
     }
 
     sdkCtx.Logger().Info("Distributed block reward", "proposer", accountAddress.String(), "amount", rewardAmount.String())
+
+    if err := k.RecordReward(sdkCtx, valAddr, accountAddress, rewardAmount, false); err != nil {
+        return fmt.Errorf("failed to record block reward: %w", err)
+    }
     return nil
 }
 
+// unreservedBalance is the part of the module balance that is not set aside
+// for accrued or withheld rewards.
+func (k Keeper) unreservedBalance(ctx sdk.Context) sdk.Coins {
+    balance := k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName))
+    reserved := k.GetTotalAccrued(ctx).Add(k.GetTotalWithheld(ctx)...)
+    unreserved, _ := balance.SafeSub(reserved...)
+    return unreserved
+}
+
 func (k Keeper) GetParams(ctx sdk.Context) (types.Params, error) {
     store := ctx.KVStore(k.storeKey)
-    bz := store.Get([]byte("Params"))
+    bz := store.Get(types.ParamsKey)
     if bz == nil {
         return types.Params{}, fmt.Errorf("params not found")
     }
@@ -98,6 +202,32 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
         return fmt.Errorf("failed to marshal Params: %w", err)
     }
 
-    store.Set([]byte("Params"), bz)
+    store.Set(types.ParamsKey, bz)
     return nil
-}
\ No newline at end of file
+}
+
+// SetBlockTxCount records the number of txs included in the block being
+// finalized. It is called from the app's PreBlocker, which is the only place
+// that sees the raw block, and read back by the EndBlocker.
+func (k Keeper) SetBlockTxCount(ctx sdk.Context, count uint64) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	ctx.TransientStore(k.tStoreKey).Set(types.BlockTxCountKey, bz)
+}
+
+// GetBlockTxCount returns the number of txs included in the current block.
+func (k Keeper) GetBlockTxCount(ctx sdk.Context) uint64 {
+	bz := ctx.TransientStore(k.tStoreKey).Get(types.BlockTxCountKey)
+	if len(bz) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// GetBlockGasUsed returns the gas consumed so far by the block being finalized.
+func (k Keeper) GetBlockGasUsed(ctx sdk.Context) uint64 {
+	if ctx.BlockGasMeter() == nil {
+		return 0
+	}
+	return ctx.BlockGasMeter().GasConsumed()
+}
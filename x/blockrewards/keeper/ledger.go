@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"sort"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-provider/x/blockrewards/types"
+)
+
+// =========================
+// Rewards ledger
+// Cumulative: ValidatorRewardsPrefix + valAddr           -> ValidatorRewardRecord
+// Per epoch:  EpochRewardsPrefix + epoch + valAddr       -> EpochValidatorRewards
+// =========================
+
+// RecordReward adds a paid block reward to the validator's cumulative total
+// and to its total for the epoch of the current block, prunes epochs that
+// fell out of the retained window and emits EventBlockRewardPaid. accrued
+// tells whether the reward was credited for a later claim rather than sent.
+func (k Keeper) RecordReward(ctx sdk.Context, valAddr sdk.ValAddress, recipient sdk.AccAddress, amount sdk.Coins, accrued bool) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	epoch := params.Epoch(ctx.BlockHeight())
+
+	total, _ := k.GetValidatorRewards(ctx, valAddr)
+	total.ValidatorAddress = valAddr.String()
+	total.TotalRewards = total.TotalRewards.Add(amount...)
+	total.BlocksRewarded++
+	k.SetValidatorRewards(ctx, valAddr, total)
+
+	epochRewards, _ := k.GetEpochRewards(ctx, epoch, valAddr)
+	epochRewards.Epoch = epoch
+	epochRewards.ValidatorAddress = valAddr.String()
+	epochRewards.Rewards = epochRewards.Rewards.Add(amount...)
+	epochRewards.BlocksRewarded++
+	k.SetEpochRewards(ctx, epochRewards)
+
+	k.PruneEpochRewards(ctx, params, epoch)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventBlockRewardPaid{
+		ValidatorAddress: valAddr.String(),
+		Recipient:        recipient.String(),
+		Amount:           amount,
+		Height:           ctx.BlockHeight(),
+		Epoch:            epoch,
+		Accrued:          accrued,
+	})
+}
+
+func (k Keeper) GetValidatorRewards(ctx sdk.Context, valAddr sdk.ValAddress) (types.ValidatorRewardRecord, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ValidatorRewardsKey(valAddr))
+	if bz == nil {
+		return types.ValidatorRewardRecord{}, false
+	}
+	var r types.ValidatorRewardRecord
+	k.cdc.MustUnmarshal(bz, &r)
+	return r, true
+}
+
+func (k Keeper) SetValidatorRewards(ctx sdk.Context, valAddr sdk.ValAddress, r types.ValidatorRewardRecord) {
+	ctx.KVStore(k.storeKey).Set(types.ValidatorRewardsKey(valAddr), k.cdc.MustMarshal(&r))
+}
+
+// IterateValidatorRewards walks the cumulative ledger in validator address order.
+func (k Keeper) IterateValidatorRewards(ctx sdk.Context, cb func(r types.ValidatorRewardRecord) (stop bool)) {
+	ps := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorRewardsPrefix)
+	it := ps.Iterator(nil, nil)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var r types.ValidatorRewardRecord
+		k.cdc.MustUnmarshal(it.Value(), &r)
+		if cb(r) {
+			return
+		}
+	}
+}
+
+func (k Keeper) GetEpochRewards(ctx sdk.Context, epoch uint64, valAddr sdk.ValAddress) (types.EpochValidatorRewards, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.EpochRewardsKey(epoch, valAddr))
+	if bz == nil {
+		return types.EpochValidatorRewards{}, false
+	}
+	var r types.EpochValidatorRewards
+	k.cdc.MustUnmarshal(bz, &r)
+	return r, true
+}
+
+func (k Keeper) SetEpochRewards(ctx sdk.Context, r types.EpochValidatorRewards) {
+	valAddr, err := sdk.ValAddressFromBech32(r.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.EpochRewardsKey(r.Epoch, valAddr), k.cdc.MustMarshal(&r))
+}
+
+// IterateEpochRewards walks the per-epoch ledger, oldest epoch first.
+func (k Keeper) IterateEpochRewards(ctx sdk.Context, cb func(r types.EpochValidatorRewards) (stop bool)) {
+	k.iterateEpochRewards(ctx, types.EpochRewardsPrefix, cb)
+}
+
+// IterateEpochRewardsForEpoch walks the ledger entries of a single epoch.
+func (k Keeper) IterateEpochRewardsForEpoch(ctx sdk.Context, epoch uint64, cb func(r types.EpochValidatorRewards) (stop bool)) {
+	k.iterateEpochRewards(ctx, types.EpochRewardsEpochPrefix(epoch), cb)
+}
+
+func (k Keeper) iterateEpochRewards(ctx sdk.Context, pfx []byte, cb func(r types.EpochValidatorRewards) (stop bool)) {
+	ps := prefix.NewStore(ctx.KVStore(k.storeKey), pfx)
+	it := ps.Iterator(nil, nil)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var r types.EpochValidatorRewards
+		k.cdc.MustUnmarshal(it.Value(), &r)
+		if cb(r) {
+			return
+		}
+	}
+}
+
+// PruneEpochRewards deletes the ledger entries of every epoch older than the
+// retained window ending at currentEpoch.
+func (k Keeper) PruneEpochRewards(ctx sdk.Context, params types.Params, currentEpoch uint64) {
+	if currentEpoch <= params.LedgerEpochsRetained {
+		return
+	}
+	oldestKept := currentEpoch - params.LedgerEpochsRetained
+
+	store := ctx.KVStore(k.storeKey)
+	it := store.Iterator(types.EpochRewardsPrefix, types.EpochRewardsEpochPrefix(oldestKept))
+	var stale [][]byte
+	for ; it.Valid(); it.Next() {
+		stale = append(stale, it.Key())
+	}
+	it.Close()
+
+	for _, key := range stale {
+		store.Delete(key)
+	}
+}
+
+// TopEarners returns up to limit ledger entries sorted by the amount earned in
+// denom, highest first. Ties are broken by validator address.
+func TopEarners(entries []types.EpochValidatorRewards, denom string, limit int) []types.EpochValidatorRewards {
+	sort.SliceStable(entries, func(i, j int) bool {
+		ai, aj := entries[i].Rewards.AmountOf(denom), entries[j].Rewards.AmountOf(denom)
+		if !ai.Equal(aj) {
+			return ai.GT(aj)
+		}
+		return entries[i].ValidatorAddress < entries[j].ValidatorAddress
+	})
+	if limit > 0 && len(entries) > limit {
+		entries = entries[:limit]
+	}
+	return entries
+}
@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-provider/app/helpers"
+	"github.com/maany-xyz/maany-provider/x/blockrewards/keeper"
+	"github.com/maany-xyz/maany-provider/x/blockrewards/types"
+)
+
+func TestRecordRewardLedger(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+	k := gaiaApp.BlockRewardsKeeper
+
+	params := types.DefaultParams()
+	params.EpochLength = 10
+	params.LedgerEpochsRetained = 1
+	require.NoError(t, k.SetParams(ctx, params))
+
+	val1 := sdk.ValAddress([]byte("validator-1_________"))
+	val2 := sdk.ValAddress([]byte("validator-2_________"))
+	acc1 := sdk.AccAddress(val1)
+	acc2 := sdk.AccAddress(val2)
+	reward := sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(100)))
+
+	// epoch 0
+	ctx = ctx.WithBlockHeight(5)
+	require.NoError(t, k.RecordReward(ctx, val1, acc1, reward, false))
+	require.NoError(t, k.RecordReward(ctx, val1, acc1, reward, false))
+	require.NoError(t, k.RecordReward(ctx, val2, acc2, reward, false))
+
+	// epoch 1
+	ctx = ctx.WithBlockHeight(15)
+	require.NoError(t, k.RecordReward(ctx, val2, acc2, reward, false))
+
+	total, found := k.GetValidatorRewards(ctx, val1)
+	require.True(t, found)
+	require.Equal(t, "200stake", total.TotalRewards.String())
+	require.Equal(t, uint64(2), total.BlocksRewarded)
+
+	_, found = k.GetEpochRewards(ctx, 0, val1)
+	require.True(t, found)
+
+	// epoch 2 prunes epoch 0, keeps epoch 1
+	ctx = ctx.WithBlockHeight(25)
+	require.NoError(t, k.RecordReward(ctx, val1, acc1, reward, false))
+
+	_, found = k.GetEpochRewards(ctx, 0, val1)
+	require.False(t, found)
+	_, found = k.GetEpochRewards(ctx, 1, val2)
+	require.True(t, found)
+
+	// cumulative totals are never pruned
+	total, _ = k.GetValidatorRewards(ctx, val1)
+	require.Equal(t, "300stake", total.TotalRewards.String())
+
+	// genesis round trip
+	gs := k.ExportGenesis(ctx)
+	require.Len(t, gs.ValidatorRewards, 2)
+	require.Len(t, gs.EpochRewards, 2)
+	require.NoError(t, gs.Validate())
+}
+
+func TestTopEarners(t *testing.T) {
+	entries := []types.EpochValidatorRewards{
+		{ValidatorAddress: "a", Rewards: sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(10)))},
+		{ValidatorAddress: "b", Rewards: sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(30)))},
+		{ValidatorAddress: "c", Rewards: sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(30)))},
+		{ValidatorAddress: "d", Rewards: sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(20)))},
+	}
+
+	top := keeper.TopEarners(entries, "stake", 3)
+	require.Len(t, top, 3)
+	require.Equal(t, "b", top[0].ValidatorAddress)
+	require.Equal(t, "c", top[1].ValidatorAddress)
+	require.Equal(t, "d", top[2].ValidatorAddress)
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/maany-xyz/maany-provider/x/blockrewards/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// Ensure msgServer implements the generated MsgServer.
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the blockrewards MsgServer.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return msgServer{k}
+}
+
+// ClaimBlockRewards withdraws the accrued rewards of the signer's validator.
+func (m msgServer) ClaimBlockRewards(goCtx context.Context, msg *types.MsgClaimBlockRewards) (*types.MsgClaimBlockRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	claimer, err := sdk.AccAddressFromBech32(msg.Claimer)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress
+	}
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress
+	}
+	if !claimer.Equals(sdk.AccAddress(valAddr)) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "claimer must be the validator operator account")
+	}
+
+	claimed, compounded, err := m.ClaimRewards(ctx, valAddr, msg.Compound)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgClaimBlockRewardsResponse{Amount: claimed, Compounded: compounded}, nil
+}
+
+// UpdateParams replaces the module parameters.
+func (m msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if msg.Authority != m.authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.authority, msg.Authority)
+	}
+	if err := msg.Params.Validate(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateParamsResponse{}, nil
+}
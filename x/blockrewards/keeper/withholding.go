@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-provider/x/blockrewards/types"
+)
+
+// =========================
+// Withholding
+// Per reward: WithheldRewardsPrefix + release height + valAddr -> WithheldReward
+// Index:      WithheldByValidatorPrefix + valAddr + release height -> empty
+// Total:      TotalWithheldKey                                -> AccruedRewards (sum, no validator)
+// Withheld coins stay in the module account until they are released to the
+// validator or clawed back to the community pool.
+// =========================
+
+// WithholdReward holds amount back for the validator until releaseHeight.
+func (k Keeper) WithholdReward(ctx sdk.Context, valAddr sdk.ValAddress, amount sdk.Coins, releaseHeight int64) error {
+	if unreserved := k.unreservedBalance(ctx); !unreserved.IsAllGTE(amount) {
+		return fmt.Errorf("insufficient unreserved module balance: unreserved %s, reward %s", unreserved, amount)
+	}
+
+	w, found := k.getWithheldReward(ctx, releaseHeight, valAddr)
+	if !found {
+		w = types.WithheldReward{
+			ValidatorAddress: valAddr.String(),
+			EarnedHeight:     ctx.BlockHeight(),
+			ReleaseHeight:    releaseHeight,
+		}
+	}
+	w.Rewards = w.Rewards.Add(amount...)
+	k.SetWithheldReward(ctx, w)
+	k.setTotalWithheld(ctx, k.GetTotalWithheld(ctx).Add(amount...))
+	return nil
+}
+
+// ReleaseWithheldRewards pays out every withheld reward whose release height
+// has been reached, according to the current payout mode.
+func (k Keeper) ReleaseWithheldRewards(ctx sdk.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	it := store.Iterator(types.WithheldRewardsPrefix, types.WithheldRewardsHeightPrefix(ctx.BlockHeight()+1))
+	var due []types.WithheldReward
+	for ; it.Valid(); it.Next() {
+		var w types.WithheldReward
+		k.cdc.MustUnmarshal(it.Value(), &w)
+		due = append(due, w)
+	}
+	it.Close()
+
+	// each release is written only if its payout succeeds, a failed one stays
+	// withheld and is retried at the next block
+	var errs []error
+	for _, w := range due {
+		valAddr, err := sdk.ValAddressFromBech32(w.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+		cacheCtx, write := ctx.CacheContext()
+		k.deleteWithheldReward(cacheCtx, w.ReleaseHeight, valAddr)
+		total, _ := k.GetTotalWithheld(cacheCtx).SafeSub(w.Rewards...)
+		k.setTotalWithheld(cacheCtx, total)
+		if err := k.payout(cacheCtx, params, valAddr, w.Rewards); err != nil {
+			errs = append(errs, fmt.Errorf("failed to release withheld reward of %s: %w", w.ValidatorAddress, err))
+			continue
+		}
+		write()
+	}
+	return errors.Join(errs...)
+}
+
+// ClawbackWithheldRewards sends every reward still withheld for the validator
+// to the community pool. It is called when the validator gets slashed.
+func (k Keeper) ClawbackWithheldRewards(ctx sdk.Context, valAddr sdk.ValAddress) (sdk.Coins, error) {
+	clawedBack := sdk.NewCoins()
+	var releaseHeights []int64
+	ps := prefix.NewStore(ctx.KVStore(k.storeKey), types.WithheldByValidatorValPrefix(valAddr))
+	it := ps.Iterator(nil, nil)
+	for ; it.Valid(); it.Next() {
+		releaseHeight := int64(binary.BigEndian.Uint64(it.Key()))
+		if w, found := k.getWithheldReward(ctx, releaseHeight, valAddr); found {
+			clawedBack = clawedBack.Add(w.Rewards...)
+		}
+		releaseHeights = append(releaseHeights, releaseHeight)
+	}
+	it.Close()
+	if clawedBack.IsZero() {
+		return clawedBack, nil
+	}
+
+	for _, releaseHeight := range releaseHeights {
+		k.deleteWithheldReward(ctx, releaseHeight, valAddr)
+	}
+	total, _ := k.GetTotalWithheld(ctx).SafeSub(clawedBack...)
+	k.setTotalWithheld(ctx, total)
+
+	if err := k.distrKeeper.FundCommunityPool(ctx, clawedBack, k.accountKeeper.GetModuleAddress(types.ModuleName)); err != nil {
+		return nil, fmt.Errorf("failed to send clawed back block rewards to the community pool: %w", err)
+	}
+
+	ctx.Logger().Info("Clawed back withheld block rewards", "validator", valAddr.String(), "amount", clawedBack.String())
+	return clawedBack, ctx.EventManager().EmitTypedEvent(&types.EventBlockRewardsClawedBack{
+		ValidatorAddress: valAddr.String(),
+		Amount:           clawedBack,
+	})
+}
+
+func (k Keeper) getWithheldReward(ctx sdk.Context, releaseHeight int64, valAddr sdk.ValAddress) (types.WithheldReward, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.WithheldRewardsKey(releaseHeight, valAddr))
+	if bz == nil {
+		return types.WithheldReward{}, false
+	}
+	var w types.WithheldReward
+	k.cdc.MustUnmarshal(bz, &w)
+	return w, true
+}
+
+func (k Keeper) SetWithheldReward(ctx sdk.Context, w types.WithheldReward) {
+	valAddr, err := sdk.ValAddressFromBech32(w.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.WithheldRewardsKey(w.ReleaseHeight, valAddr), k.cdc.MustMarshal(&w))
+	store.Set(types.WithheldByValidatorKey(valAddr, w.ReleaseHeight), []byte{})
+}
+
+func (k Keeper) deleteWithheldReward(ctx sdk.Context, releaseHeight int64, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.WithheldRewardsKey(releaseHeight, valAddr))
+	store.Delete(types.WithheldByValidatorKey(valAddr, releaseHeight))
+}
+
+// IterateWithheldRewards walks all withheld rewards, earliest release first.
+func (k Keeper) IterateWithheldRewards(ctx sdk.Context, cb func(w types.WithheldReward) (stop bool)) {
+	ps := prefix.NewStore(ctx.KVStore(k.storeKey), types.WithheldRewardsPrefix)
+	it := ps.Iterator(nil, nil)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var w types.WithheldReward
+		k.cdc.MustUnmarshal(it.Value(), &w)
+		if cb(w) {
+			return
+		}
+	}
+}
+
+// GetTotalWithheld returns the sum of all withheld rewards.
+func (k Keeper) GetTotalWithheld(ctx sdk.Context) sdk.Coins {
+	bz := ctx.KVStore(k.storeKey).Get(types.TotalWithheldKey)
+	if bz == nil {
+		return sdk.NewCoins()
+	}
+	var r types.AccruedRewards
+	k.cdc.MustUnmarshal(bz, &r)
+	return r.Rewards
+}
+
+func (k Keeper) setTotalWithheld(ctx sdk.Context, total sdk.Coins) {
+	r := types.AccruedRewards{Rewards: total}
+	ctx.KVStore(k.storeKey).Set(types.TotalWithheldKey, k.cdc.MustMarshal(&r))
+}
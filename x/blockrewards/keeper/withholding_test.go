@@ -0,0 +1,218 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+
+	"github.com/maany-xyz/maany-provider/app/helpers"
+	"github.com/maany-xyz/maany-provider/x/blockrewards/types"
+)
+
+func TestWithholdAndRelease(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+	k := gaiaApp.BlockRewardsKeeper
+
+	validators, err := gaiaApp.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	valAddr, err := sdk.ValAddressFromBech32(validators[0].GetOperator())
+	require.NoError(t, err)
+	consAddr, err := validators[0].GetConsAddr()
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.WithholdingPeriod = 10
+	require.NoError(t, k.SetParams(ctx, params))
+
+	reward := sdk.NewCoins(params.BlockRewardAmount)
+	require.NoError(t, gaiaApp.BankKeeper.MintCoins(ctx, types.ModuleName, reward))
+
+	ctx = ctx.WithBlockHeight(5).WithProposer(consAddr)
+	require.NoError(t, k.DistributeRewards(ctx, ctx, reward))
+	require.Equal(t, reward, k.GetTotalWithheld(ctx))
+	require.True(t, k.GetAccruedRewards(ctx, valAddr).IsZero())
+
+	// withheld coins are reserved, the next proposer can't be rewarded with them
+	require.Error(t, k.DistributeRewards(ctx, ctx, reward))
+
+	// nothing is released before the withholding period ends
+	ctx = ctx.WithBlockHeight(14)
+	require.NoError(t, k.ReleaseWithheldRewards(ctx))
+	require.Equal(t, reward, k.GetTotalWithheld(ctx))
+
+	ctx = ctx.WithBlockHeight(15)
+	require.NoError(t, k.ReleaseWithheldRewards(ctx))
+	require.True(t, k.GetTotalWithheld(ctx).IsZero())
+	require.Equal(t, reward, k.GetAccruedRewards(ctx, valAddr))
+}
+
+func TestClawbackWithheldRewardsOnSlash(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+	k := gaiaApp.BlockRewardsKeeper
+
+	validators, err := gaiaApp.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	valAddr, err := sdk.ValAddressFromBech32(validators[0].GetOperator())
+	require.NoError(t, err)
+	consAddr, err := validators[0].GetConsAddr()
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.WithholdingPeriod = 10
+	require.NoError(t, k.SetParams(ctx, params))
+
+	reward := sdk.NewCoins(params.BlockRewardAmount)
+	require.NoError(t, gaiaApp.BankKeeper.MintCoins(ctx, types.ModuleName, reward.Add(reward...)))
+
+	ctx = ctx.WithBlockHeight(5).WithProposer(consAddr)
+	require.NoError(t, k.DistributeRewards(ctx, ctx, reward))
+	ctx = ctx.WithBlockHeight(6)
+	require.NoError(t, k.DistributeRewards(ctx, ctx, reward))
+	require.Equal(t, reward.Add(reward...), k.GetTotalWithheld(ctx))
+
+	// rewards withheld for another validator are left alone
+	otherVal := sdk.ValAddress("other_validator_____")
+	other := types.WithheldReward{ValidatorAddress: otherVal.String(), ReleaseHeight: 16, Rewards: reward}
+	k.SetWithheldReward(ctx, other)
+
+	feePool, err := gaiaApp.DistrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	poolBefore := feePool.CommunityPool
+
+	// a double sign reported through x/evidence slashes via x/slashing, which
+	// triggers the staking hook
+	power := gaiaApp.StakingKeeper.TokensToConsensusPower(ctx, validators[0].GetTokens())
+	require.NoError(t, gaiaApp.SlashingKeeper.Slash(ctx, sdk.ConsAddress(consAddr), math.LegacyNewDecWithPrec(5, 2), power, 5))
+
+	require.True(t, k.GetTotalWithheld(ctx).IsZero())
+	var remaining []types.WithheldReward
+	k.IterateWithheldRewards(ctx, func(w types.WithheldReward) bool {
+		remaining = append(remaining, w)
+		return false
+	})
+	require.Equal(t, []types.WithheldReward{other}, remaining)
+
+	feePool, err = gaiaApp.DistrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	clawedBack := sdk.NewDecCoinsFromCoins(reward.Add(reward...)...)
+	require.Equal(t, poolBefore.Add(clawedBack...), feePool.CommunityPool)
+
+	// the clawed back rewards never become claimable
+	ctx = ctx.WithBlockHeight(15)
+	require.NoError(t, k.ReleaseWithheldRewards(ctx))
+	require.True(t, k.GetAccruedRewards(ctx, valAddr).IsZero())
+}
+
+func TestFailedReleaseKeepsWithheldReward(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+	k := gaiaApp.BlockRewardsKeeper
+
+	validators, err := gaiaApp.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	valAddr, err := sdk.ValAddressFromBech32(validators[0].GetOperator())
+	require.NoError(t, err)
+	consAddr, err := validators[0].GetConsAddr()
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.WithholdingPeriod = 10
+	params.PayoutMode = types.PAYOUT_MODE_PUSH
+	require.NoError(t, k.SetParams(ctx, params))
+
+	reward := sdk.NewCoins(params.BlockRewardAmount)
+	require.NoError(t, gaiaApp.BankKeeper.MintCoins(ctx, types.ModuleName, reward))
+
+	ctx = ctx.WithBlockHeight(5).WithProposer(consAddr)
+	require.NoError(t, k.DistributeRewards(ctx, ctx, reward))
+
+	// drain the module so the direct payout can't be made
+	sink := sdk.AccAddress("reward_sink_________")
+	require.NoError(t, gaiaApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sink, reward))
+
+	balanceBefore := gaiaApp.BankKeeper.GetAllBalances(ctx, sdk.AccAddress(valAddr))
+	ctx = ctx.WithBlockHeight(15)
+	require.Error(t, k.ReleaseWithheldRewards(ctx))
+	require.Equal(t, reward, k.GetTotalWithheld(ctx))
+	var remaining []types.WithheldReward
+	k.IterateWithheldRewards(ctx, func(w types.WithheldReward) bool {
+		remaining = append(remaining, w)
+		return false
+	})
+	require.Len(t, remaining, 1)
+
+	// the reward is released once the module can pay it again
+	require.NoError(t, gaiaApp.BankKeeper.SendCoinsFromAccountToModule(ctx, sink, types.ModuleName, reward))
+	ctx = ctx.WithBlockHeight(16)
+	require.NoError(t, k.ReleaseWithheldRewards(ctx))
+	require.True(t, k.GetTotalWithheld(ctx).IsZero())
+	require.Equal(t, balanceBefore.Add(reward...), gaiaApp.BankKeeper.GetAllBalances(ctx, sdk.AccAddress(valAddr)))
+}
+
+func TestIneligibleProposersAreNotRewarded(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+	k := gaiaApp.BlockRewardsKeeper
+
+	validators, err := gaiaApp.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	valAddr, err := sdk.ValAddressFromBech32(validators[0].GetOperator())
+	require.NoError(t, err)
+	consAddr, err := validators[0].GetConsAddr()
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	reward := sdk.NewCoins(params.BlockRewardAmount)
+	require.NoError(t, gaiaApp.BankKeeper.MintCoins(ctx, types.ModuleName, reward))
+	ctx = ctx.WithBlockHeight(5).WithProposer(consAddr)
+
+	// the proposer does not validate a required consumer chain
+	params.RequiredConsumerChains = []string{"consumer-1"}
+	require.NoError(t, k.SetParams(ctx, params))
+	require.NoError(t, k.DistributeRewards(ctx, ctx, reward))
+	require.True(t, k.GetAccruedRewards(ctx, valAddr).IsZero())
+
+	params.RequiredConsumerChains = nil
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// tombstoned proposers are skipped
+	require.NoError(t, gaiaApp.SlashingKeeper.SetValidatorSigningInfo(ctx, sdk.ConsAddress(consAddr),
+		slashingtypes.NewValidatorSigningInfo(sdk.ConsAddress(consAddr), 0, 0, time.Unix(0, 0), false, 0)))
+	require.NoError(t, gaiaApp.SlashingKeeper.Tombstone(ctx, sdk.ConsAddress(consAddr)))
+	require.NoError(t, k.DistributeRewards(ctx, ctx, reward))
+	require.True(t, k.GetAccruedRewards(ctx, valAddr).IsZero())
+	require.True(t, k.GetTotalAccrued(ctx).IsZero())
+}
+
+func TestJailedProposerIsNotRewarded(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+	k := gaiaApp.BlockRewardsKeeper
+
+	validators, err := gaiaApp.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	valAddr, err := sdk.ValAddressFromBech32(validators[0].GetOperator())
+	require.NoError(t, err)
+	consAddr, err := validators[0].GetConsAddr()
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	require.NoError(t, k.SetParams(ctx, params))
+	reward := sdk.NewCoins(params.BlockRewardAmount)
+	require.NoError(t, gaiaApp.BankKeeper.MintCoins(ctx, types.ModuleName, reward))
+	ctx = ctx.WithBlockHeight(5).WithProposer(consAddr)
+
+	require.NoError(t, gaiaApp.SlashingKeeper.Jail(ctx, sdk.ConsAddress(consAddr)))
+	require.NoError(t, k.DistributeRewards(ctx, ctx, reward))
+	require.True(t, k.GetAccruedRewards(ctx, valAddr).IsZero())
+}
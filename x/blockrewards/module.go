@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -76,6 +77,65 @@ func (AppModule) QuerierRoute() string { return types.ModuleName }
 
 // RegisterServices registers the module's services.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
+	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServer(am.keeper))
+}
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: "maany.blockrewards.v1.Query",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the blockrewards parameters",
+				},
+				{
+					RpcMethod: "ValidatorRewards",
+					Use:       "validator-rewards [validator-address]",
+					Short:     "Query the block rewards earned by a validator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+					},
+				},
+				{
+					RpcMethod: "TopEarners",
+					Use:       "top-earners",
+					Short:     "List the validators that earned the most block rewards",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"all_time": {Name: "all-time", Usage: "Rank by cumulative rewards instead of a single epoch"},
+					},
+				},
+				{
+					RpcMethod: "FeeTotals",
+					Use:       "fee-totals",
+					Short:     "Query the cumulative tx fees redirected to block rewards and burned",
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: "maany.blockrewards.v1.Msg",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "ClaimBlockRewards",
+					Use:       "claim [validator-address]",
+					Short:     "Withdraw the block rewards accrued by a validator to its operator account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"compound": {Name: "compound", Usage: "Delegate the claimed rewards back to the validator"},
+					},
+				},
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+			},
+		},
+	}
 }
 
 func (am AppModule) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
@@ -127,18 +187,27 @@ func (am AppModule) EndBlock(ctx context.Context) error {
 // }
 // EndBlocker is the core logic for the blockrewards module at the end of each block.
 func EndBlocker (sdkContext sdk.Context, ctx context.Context, k keeper.Keeper) {	
-	// if sdkContext.TxBytes() == nil || len(sdkContext.TxBytes()) == 0 {
-    //     sdkContext.Logger().Info("Empty block detected, no transactions included.")
-    //     return // Skip rewards for empty blocks
-    // }
 	params, err := k.GetParams(sdkContext)
     if err != nil {
         sdkContext.Logger().Error("failed to get block reward params in EndBlocker", "error", err.Error())
         return
     }
 
-    // Use the block_reward_amount from the retrieved params
-    rewardAmount := sdk.NewCoins(params.BlockRewardAmount)
+	// Pay out rewards whose withholding period ends at this height
+	if err := k.ReleaseWithheldRewards(sdkContext); err != nil {
+		sdkContext.Logger().Error("failed to release withheld block rewards", "error", err.Error())
+	}
+
+	// Size the reward according to the configured policy and the usage of the
+	// block that is being finalized.
+	txCount := k.GetBlockTxCount(sdkContext)
+	gasUsed := k.GetBlockGasUsed(sdkContext)
+	reward := params.BlockReward(txCount, gasUsed)
+	if !reward.IsPositive() {
+		sdkContext.Logger().Debug("no block reward owed", "policy", params.RewardPolicy.String(), "txs", txCount, "gas_used", gasUsed)
+		return
+	}
+    rewardAmount := sdk.NewCoins(reward)
 
 	// Call the reward distribution logic from the Keeper
 	err2 := k.DistributeRewards(sdkContext, ctx, rewardAmount)
@@ -0,0 +1,93 @@
+package post
+
+import (
+	feemarkettypes "github.com/skip-mev/feemarket/x/feemarket/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/maany-xyz/maany-provider/x/blockrewards/keeper"
+)
+
+// FeeMarketKeeper defines the fee market keeper the FeeShareDecorator reads
+// the params of.
+type FeeMarketKeeper interface {
+	GetParams(ctx sdk.Context) (feemarkettypes.Params, error)
+}
+
+// FeeShareDecorator is a post decorator that routes a governance-set share of
+// the fees paid by a tx to the blockrewards module and burns another share. It
+// must run before the feemarket deduct decorator, whose fee pay events give the
+// fee the tx paid, tip to the proposer excluded. The fee is still held by the
+// feemarket fee collector unless the feemarket distributes fees to the auth fee
+// collector. If the feemarket is disabled, the fallback ante decorator already
+// deducted the whole fee to the auth fee collector.
+type FeeShareDecorator struct {
+	keeper          *keeper.Keeper
+	feeMarketKeeper FeeMarketKeeper
+}
+
+// NewFeeShareDecorator returns a FeeShareDecorator.
+func NewFeeShareDecorator(k *keeper.Keeper, fmk FeeMarketKeeper) FeeShareDecorator {
+	return FeeShareDecorator{keeper: k, feeMarketKeeper: fmk}
+}
+
+func (d FeeShareDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	params, err := d.feeMarketKeeper.GetParams(ctx)
+	if err != nil {
+		return ctx, err
+	}
+
+	if !params.Enabled {
+		ctx, err := next(ctx, tx, simulate, success)
+		if err != nil {
+			return ctx, err
+		}
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok {
+			return ctx, nil
+		}
+		return ctx, d.keeper.RouteFees(ctx, authtypes.FeeCollectorName, feeTx.GetFee())
+	}
+
+	// collect the events of the next decorators apart to find the fee they paid
+	parent := ctx.EventManager()
+	ctx, err = next(ctx.WithEventManager(sdk.NewEventManager()), tx, simulate, success)
+	events := ctx.EventManager().Events()
+	ctx = ctx.WithEventManager(parent)
+	parent.EmitEvents(events)
+	if err != nil {
+		return ctx, err
+	}
+
+	paid, err := paidFees(events)
+	if err != nil {
+		return ctx, err
+	}
+	collector := feemarkettypes.FeeCollectorName
+	if params.DistributeFees {
+		collector = authtypes.FeeCollectorName
+	}
+	return ctx, d.keeper.RouteFees(ctx, collector, paid)
+}
+
+// paidFees sums the fees of the feemarket fee pay events.
+func paidFees(events sdk.Events) (sdk.Coins, error) {
+	paid := sdk.NewCoins()
+	for _, event := range events {
+		if event.Type != feemarkettypes.EventTypeFeePay {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key != sdk.AttributeKeyFee {
+				continue
+			}
+			fee, err := sdk.ParseCoinsNormalized(attr.Value)
+			if err != nil {
+				return nil, err
+			}
+			paid = paid.Add(fee...)
+		}
+	}
+	return paid, nil
+}
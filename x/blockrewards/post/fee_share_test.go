@@ -0,0 +1,98 @@
+package post_test
+
+import (
+	"testing"
+
+	feemarkettypes "github.com/skip-mev/feemarket/x/feemarket/types"
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	gaia "github.com/maany-xyz/maany-provider/app"
+	"github.com/maany-xyz/maany-provider/app/helpers"
+	"github.com/maany-xyz/maany-provider/x/blockrewards/types"
+)
+
+func TestFeeSharePostHandler(t *testing.T) {
+	for _, distributeFees := range []bool{false, true} {
+		gaiaApp := helpers.Setup(t)
+		ctx := gaiaApp.NewUncachedContext(false, tmproto.Header{Height: 10, ProposerAddress: []byte("proposer____________")})
+
+		fmParams, err := gaiaApp.FeeMarketKeeper.GetParams(ctx)
+		require.NoError(t, err)
+		// the default feemarket params keep the fees in the feemarket collector
+		require.False(t, fmParams.DistributeFees)
+		fmParams.DistributeFees = distributeFees
+		require.NoError(t, gaiaApp.FeeMarketKeeper.SetParams(ctx, fmParams))
+
+		params := types.DefaultParams()
+		params.FeeRedirectShare = math.LegacyNewDecWithPrec(4, 1)
+		params.FeeBurnShare = math.LegacyNewDecWithPrec(1, 1)
+		require.NoError(t, gaiaApp.BlockRewardsKeeper.SetParams(ctx, params))
+
+		postHandler, err := gaia.NewPostHandler(gaia.PostHandlerOptions{
+			AccountKeeper:      gaiaApp.AccountKeeper,
+			BankKeeper:         gaiaApp.BankKeeper,
+			FeeMarketKeeper:    gaiaApp.FeeMarketKeeper,
+			BlockRewardsKeeper: &gaiaApp.BlockRewardsKeeper,
+		})
+		require.NoError(t, err)
+
+		// the ante handler escrowed the fee in the feemarket collector
+		fee := sdk.NewCoins(sdk.NewInt64Coin(fmParams.FeeDenom, 1_000_000))
+		require.NoError(t, gaiaApp.BankKeeper.MintCoins(ctx, types.ModuleName, fee))
+		require.NoError(t, gaiaApp.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, feemarkettypes.FeeCollectorName, fee))
+
+		txBuilder := gaiaApp.GetTxConfig().NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(sdk.AccAddress("from"), sdk.AccAddress("to"), fee)))
+		txBuilder.SetFeeAmount(fee)
+		txBuilder.SetGasLimit(1_000_000)
+
+		ctx = ctx.WithGasMeter(storetypes.NewGasMeter(1_000_000)).WithEventManager(sdk.NewEventManager())
+		ctx.GasMeter().ConsumeGas(100_000, "tx")
+
+		balance := func(module string) math.Int {
+			return gaiaApp.BankKeeper.GetBalance(ctx, gaiaApp.AccountKeeper.GetModuleAddress(module), fmParams.FeeDenom).Amount
+		}
+		rewardsBefore := balance(types.ModuleName)
+		collectorBefore := balance(authtypes.FeeCollectorName)
+		supplyBefore := gaiaApp.BankKeeper.GetSupply(ctx, fmParams.FeeDenom).Amount
+
+		ctx, err = postHandler(ctx, txBuilder.GetTx(), false, true)
+		require.NoError(t, err)
+
+		// the fee paid is in the fee pay event, the rest is a tip to the proposer
+		var paid sdk.Coins
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == feemarkettypes.EventTypeFeePay {
+				attr, ok := event.GetAttribute(sdk.AttributeKeyFee)
+				require.True(t, ok)
+				paid, err = sdk.ParseCoinsNormalized(attr.Value)
+				require.NoError(t, err)
+			}
+		}
+		require.False(t, paid.IsZero())
+		require.True(t, paid.IsAllLT(fee))
+
+		redirect, burn := params.FeeShares(paid)
+		require.False(t, redirect.IsZero())
+		require.Equal(t, rewardsBefore.Add(redirect.AmountOf(fmParams.FeeDenom)), balance(types.ModuleName))
+		require.Equal(t, supplyBefore.Sub(burn.AmountOf(fmParams.FeeDenom)), gaiaApp.BankKeeper.GetSupply(ctx, fmParams.FeeDenom).Amount)
+
+		kept := paid.Sub(redirect...).Sub(burn...).AmountOf(fmParams.FeeDenom)
+		if distributeFees {
+			require.Equal(t, collectorBefore.Add(kept), balance(authtypes.FeeCollectorName))
+			require.True(t, balance(feemarkettypes.FeeCollectorName).IsZero())
+		} else {
+			require.Equal(t, collectorBefore, balance(authtypes.FeeCollectorName))
+			require.Equal(t, kept, balance(feemarkettypes.FeeCollectorName))
+		}
+	}
+}
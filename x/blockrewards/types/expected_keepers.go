@@ -0,0 +1,31 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	providertypes "github.com/cosmos/interchain-security/v5/x/ccv/provider/types"
+)
+
+// SlashingKeeper is used to skip rewards for tombstoned proposers.
+type SlashingKeeper interface {
+	IsTombstoned(ctx context.Context, consAddr sdk.ConsAddress) bool
+}
+
+// DistributionKeeper is used to send clawed back rewards to the community pool.
+type DistributionKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// ProviderKeeper is the subset of the ICS provider keeper used to check the
+// consumer chain participation of a proposer and to route rewards to the
+// validators of consumer chains.
+type ProviderKeeper interface {
+	IsOptedIn(ctx sdk.Context, chainID string, providerAddr providertypes.ProviderConsAddress) bool
+	IsConsumerValidator(ctx sdk.Context, chainID string, providerAddr providertypes.ProviderConsAddress) bool
+	GetAllRegisteredConsumerChainIDs(ctx sdk.Context) []string
+	ComputeConsumerTotalVotingPower(ctx sdk.Context, chainID string) int64
+	GetConsumerRewardsAllocation(ctx sdk.Context, chainID string) providertypes.ConsumerRewardsAllocation
+	SetConsumerRewardsAllocation(ctx sdk.Context, chainID string, pool providertypes.ConsumerRewardsAllocation)
+}
@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -24,9 +26,116 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// RewardPolicy selects how the per-block reward is derived from block usage.
+type RewardPolicy int32
+
+const (
+	// REWARD_POLICY_FIXED pays block_reward_amount for every block, empty or not.
+	REWARD_POLICY_FIXED RewardPolicy = 0
+	// REWARD_POLICY_EMPTY_BLOCK_FRACTION pays block_reward_amount for blocks
+	// with transactions and empty_block_reward_ratio of it for empty blocks.
+	REWARD_POLICY_EMPTY_BLOCK_FRACTION RewardPolicy = 1
+	// REWARD_POLICY_PER_TX pays reward_per_tx for every included transaction,
+	// capped at block_reward_amount.
+	REWARD_POLICY_PER_TX RewardPolicy = 2
+	// REWARD_POLICY_PER_GAS pays reward_per_gas for every unit of gas used by
+	// the block, capped at block_reward_amount.
+	REWARD_POLICY_PER_GAS RewardPolicy = 3
+)
+
+var RewardPolicy_name = map[int32]string{
+	0: "REWARD_POLICY_FIXED",
+	1: "REWARD_POLICY_EMPTY_BLOCK_FRACTION",
+	2: "REWARD_POLICY_PER_TX",
+	3: "REWARD_POLICY_PER_GAS",
+}
+
+var RewardPolicy_value = map[string]int32{
+	"REWARD_POLICY_FIXED":                0,
+	"REWARD_POLICY_EMPTY_BLOCK_FRACTION": 1,
+	"REWARD_POLICY_PER_TX":               2,
+	"REWARD_POLICY_PER_GAS":              3,
+}
+
+func (x RewardPolicy) String() string {
+	return proto.EnumName(RewardPolicy_name, int32(x))
+}
+
+func (RewardPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_dcd2ed965e6cd162, []int{0}
+}
+
+// PayoutMode selects how earned block rewards reach the validator.
+type PayoutMode int32
+
+const (
+	// PAYOUT_MODE_PUSH sends the reward to the proposer's operator account in
+	// the EndBlocker of every block.
+	PAYOUT_MODE_PUSH PayoutMode = 0
+	// PAYOUT_MODE_CLAIM accrues the reward in the module and leaves it to the
+	// operator to withdraw it with MsgClaimBlockRewards.
+	PAYOUT_MODE_CLAIM PayoutMode = 1
+)
+
+var PayoutMode_name = map[int32]string{
+	0: "PAYOUT_MODE_PUSH",
+	1: "PAYOUT_MODE_CLAIM",
+}
+
+var PayoutMode_value = map[string]int32{
+	"PAYOUT_MODE_PUSH":  0,
+	"PAYOUT_MODE_CLAIM": 1,
+}
+
+func (x PayoutMode) String() string {
+	return proto.EnumName(PayoutMode_name, int32(x))
+}
+
+func (PayoutMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_dcd2ed965e6cd162, []int{1}
+}
+
 // Params defines the parameters for the blockrewards module.
 type Params struct {
 	BlockRewardAmount types.Coin `protobuf:"bytes,1,opt,name=block_reward_amount,json=blockRewardAmount,proto3" json:"block_reward_amount"`
+	// reward_policy selects how the reward of a block is computed.
+	RewardPolicy RewardPolicy `protobuf:"varint,2,opt,name=reward_policy,json=rewardPolicy,proto3,enum=maany.blockrewards.v1.RewardPolicy" json:"reward_policy,omitempty"`
+	// empty_block_reward_ratio is the fraction of block_reward_amount paid for
+	// empty blocks under REWARD_POLICY_EMPTY_BLOCK_FRACTION.
+	EmptyBlockRewardRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=empty_block_reward_ratio,json=emptyBlockRewardRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"empty_block_reward_ratio"`
+	// reward_per_tx is the amount, in the block reward denom, paid per included
+	// transaction under REWARD_POLICY_PER_TX.
+	RewardPerTx cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=reward_per_tx,json=rewardPerTx,proto3,customtype=cosmossdk.io/math.Int" json:"reward_per_tx"`
+	// reward_per_gas is the amount, in the block reward denom, paid per unit of
+	// gas used under REWARD_POLICY_PER_GAS.
+	RewardPerGas cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=reward_per_gas,json=rewardPerGas,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"reward_per_gas"`
+	// epoch_length is the number of blocks in a rewards ledger epoch.
+	EpochLength uint64 `protobuf:"varint,6,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	// ledger_epochs_retained is the number of past epochs, besides the current
+	// one, for which per-validator rewards are kept in state.
+	LedgerEpochsRetained uint64 `protobuf:"varint,7,opt,name=ledger_epochs_retained,json=ledgerEpochsRetained,proto3" json:"ledger_epochs_retained,omitempty"`
+	// payout_mode selects between per-block transfers and claim-based accrual.
+	PayoutMode PayoutMode `protobuf:"varint,8,opt,name=payout_mode,json=payoutMode,proto3,enum=maany.blockrewards.v1.PayoutMode" json:"payout_mode,omitempty"`
+	// withholding_period is the number of blocks an earned reward is held back
+	// before it is paid out or becomes claimable. Rewards still withheld when
+	// their validator is slashed are sent to the community pool. 0 disables it.
+	WithholdingPeriod uint64 `protobuf:"varint,9,opt,name=withholding_period,json=withholdingPeriod,proto3" json:"withholding_period,omitempty"`
+	// required_consumer_chains lists the ICS consumer chain ids a proposer must
+	// validate, by opting in or by being in the consumer validator set, to be
+	// eligible for block rewards.
+	RequiredConsumerChains []string `protobuf:"bytes,10,rep,name=required_consumer_chains,json=requiredConsumerChains,proto3" json:"required_consumer_chains,omitempty"`
+	// consumer_reward_share is the fraction of each block reward sent to the
+	// ICS consumer rewards pool instead of the proposer. It is split between
+	// consumer chains by the voting power of their opted-in validator sets and
+	// paid out to those validators by the provider module.
+	ConsumerRewardShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=consumer_reward_share,json=consumerRewardShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"consumer_reward_share"`
+	// fee_redirect_share is the fraction of the tx fees deducted by the fee
+	// market, in the block reward denom, sent to the blockrewards module account
+	// to fund block rewards.
+	FeeRedirectShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=fee_redirect_share,json=feeRedirectShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_redirect_share"`
+	// fee_burn_share is the fraction of the same fees that is burned, like the
+	// base fee of EIP-1559. fee_redirect_share + fee_burn_share must not exceed 1.
+	FeeBurnShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,13,opt,name=fee_burn_share,json=feeBurnShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_burn_share"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -69,9 +178,56 @@ func (m *Params) GetBlockRewardAmount() types.Coin {
 	return types.Coin{}
 }
 
+func (m *Params) GetRewardPolicy() RewardPolicy {
+	if m != nil {
+		return m.RewardPolicy
+	}
+	return REWARD_POLICY_FIXED
+}
+
+func (m *Params) GetEpochLength() uint64 {
+	if m != nil {
+		return m.EpochLength
+	}
+	return 0
+}
+
+func (m *Params) GetLedgerEpochsRetained() uint64 {
+	if m != nil {
+		return m.LedgerEpochsRetained
+	}
+	return 0
+}
+
+func (m *Params) GetPayoutMode() PayoutMode {
+	if m != nil {
+		return m.PayoutMode
+	}
+	return PAYOUT_MODE_PUSH
+}
+
+func (m *Params) GetWithholdingPeriod() uint64 {
+	if m != nil {
+		return m.WithholdingPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetRequiredConsumerChains() []string {
+	if m != nil {
+		return m.RequiredConsumerChains
+	}
+	return nil
+}
+
 // GenesisState defines the genesis state of the blockrewards module.
 type GenesisState struct {
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Params           Params                  `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	ValidatorRewards []ValidatorRewardRecord `protobuf:"bytes,2,rep,name=validator_rewards,json=validatorRewards,proto3" json:"validator_rewards"`
+	EpochRewards     []EpochValidatorRewards `protobuf:"bytes,3,rep,name=epoch_rewards,json=epochRewards,proto3" json:"epoch_rewards"`
+	AccruedRewards   []AccruedRewards        `protobuf:"bytes,4,rep,name=accrued_rewards,json=accruedRewards,proto3" json:"accrued_rewards"`
+	WithheldRewards  []WithheldReward        `protobuf:"bytes,5,rep,name=withheld_rewards,json=withheldRewards,proto3" json:"withheld_rewards"`
+	FeeTotals        FeeTotals               `protobuf:"bytes,6,opt,name=fee_totals,json=feeTotals,proto3" json:"fee_totals"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -114,7 +270,44 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetValidatorRewards() []ValidatorRewardRecord {
+	if m != nil {
+		return m.ValidatorRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochRewards() []EpochValidatorRewards {
+	if m != nil {
+		return m.EpochRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAccruedRewards() []AccruedRewards {
+	if m != nil {
+		return m.AccruedRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWithheldRewards() []WithheldReward {
+	if m != nil {
+		return m.WithheldRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeTotals() FeeTotals {
+	if m != nil {
+		return m.FeeTotals
+	}
+	return FeeTotals{}
+}
+
 func init() {
+	proto.RegisterEnum("maany.blockrewards.v1.RewardPolicy", RewardPolicy_name, RewardPolicy_value)
+	proto.RegisterEnum("maany.blockrewards.v1.PayoutMode", PayoutMode_name, PayoutMode_value)
 	proto.RegisterType((*Params)(nil), "maany.blockrewards.v1.Params")
 	proto.RegisterType((*GenesisState)(nil), "maany.blockrewards.v1.GenesisState")
 }
@@ -124,25 +317,65 @@ func init() {
 }
 
 var fileDescriptor_dcd2ed965e6cd162 = []byte{
-	// 280 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0x31, 0x4f, 0xf3, 0x30,
-	0x10, 0x86, 0x13, 0xe9, 0x53, 0x86, 0x7c, 0x2c, 0x04, 0x90, 0xa0, 0x12, 0x06, 0x95, 0x85, 0x85,
-	0xb3, 0x02, 0x0b, 0x12, 0x13, 0x65, 0x60, 0x60, 0x00, 0x85, 0x09, 0x96, 0xca, 0x49, 0xad, 0x60,
-	0x81, 0x7d, 0x91, 0xed, 0x86, 0x86, 0x5f, 0xc1, 0xcf, 0xea, 0xd8, 0x91, 0x09, 0xa1, 0xe4, 0x8f,
-	0xa0, 0xda, 0x1e, 0x40, 0x62, 0x3b, 0xc9, 0xef, 0xf3, 0xf8, 0xee, 0x4d, 0x8f, 0x24, 0x63, 0xaa,
-	0xa3, 0xe5, 0x0b, 0x56, 0xcf, 0x9a, 0xbf, 0x32, 0x3d, 0x33, 0xb4, 0xcd, 0x69, 0xcd, 0x15, 0x37,
-	0xc2, 0x40, 0xa3, 0xd1, 0x62, 0xb6, 0xe3, 0x42, 0xf0, 0x33, 0x04, 0x6d, 0x3e, 0xda, 0xae, 0xb1,
-	0x46, 0x97, 0xa0, 0xeb, 0xc9, 0x87, 0x47, 0xa4, 0x42, 0x23, 0xd1, 0xd0, 0x92, 0x19, 0x4e, 0xdb,
-	0xbc, 0xe4, 0x96, 0xe5, 0xb4, 0x42, 0xa1, 0xfc, 0xfb, 0xf8, 0x21, 0x4d, 0xee, 0x98, 0x66, 0xd2,
-	0x64, 0xb7, 0xe9, 0x96, 0x53, 0x4e, 0xbd, 0x73, 0xca, 0x24, 0xce, 0x95, 0xdd, 0x8d, 0x0f, 0xe3,
-	0xe3, 0xff, 0xa7, 0x7b, 0xe0, 0x3d, 0xb0, 0xf6, 0x40, 0xf0, 0xc0, 0x15, 0x0a, 0x35, 0xf9, 0xb7,
-	0xfc, 0x3c, 0x88, 0x8a, 0x4d, 0xc7, 0x16, 0x0e, 0xbd, 0x74, 0xe4, 0xf8, 0x26, 0xdd, 0xb8, 0xf6,
-	0x8b, 0xdf, 0x5b, 0x66, 0x79, 0x76, 0x91, 0x26, 0x8d, 0xfb, 0x2a, 0x38, 0xf7, 0xe1, 0xcf, 0x43,
-	0xc0, 0xef, 0x13, 0xbc, 0x01, 0x99, 0x14, 0xcb, 0x9e, 0xc4, 0xab, 0x9e, 0xc4, 0x5f, 0x3d, 0x89,
-	0xdf, 0x07, 0x12, 0xad, 0x06, 0x12, 0x7d, 0x0c, 0x24, 0x7a, 0x3c, 0xaf, 0x85, 0x7d, 0x9a, 0x97,
-	0x50, 0xa1, 0xa4, 0x4e, 0x78, 0xb2, 0xe8, 0xde, 0xc2, 0xd4, 0x68, 0x6c, 0xc5, 0x8c, 0x6b, 0xba,
-	0xf8, 0xdd, 0xa9, 0xed, 0x1a, 0x6e, 0xca, 0xc4, 0x55, 0x70, 0xf6, 0x1d, 0x00, 0x00, 0xff, 0xff,
-	0xa7, 0x44, 0xf8, 0x2a, 0x76, 0x01, 0x00, 0x00,
+	// 919 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0x4f, 0x6e, 0xdb, 0x46,
+	0x14, 0xc6, 0x45, 0x4b, 0x71, 0xea, 0x91, 0xec, 0xd0, 0x63, 0x2b, 0xa5, 0x5d, 0x54, 0x51, 0x1c,
+	0xb4, 0x10, 0xd2, 0x9a, 0x84, 0xdc, 0x2e, 0x02, 0x74, 0x51, 0xe8, 0x9f, 0x1d, 0xa1, 0x72, 0x25,
+	0xd0, 0x4a, 0x14, 0x77, 0x33, 0x18, 0x91, 0xcf, 0x12, 0x1b, 0x89, 0xa3, 0xce, 0x8c, 0x64, 0xab,
+	0x27, 0x28, 0xba, 0xea, 0x1d, 0x7a, 0x85, 0x1e, 0x22, 0xcb, 0xa0, 0xab, 0xa2, 0x8b, 0x20, 0xb0,
+	0x6f, 0xd1, 0x55, 0xa1, 0xe1, 0xd0, 0x96, 0x9a, 0xca, 0x0b, 0xef, 0xc8, 0xf7, 0x7d, 0xf3, 0xfb,
+	0x1e, 0x87, 0xf3, 0x48, 0xf4, 0x64, 0x48, 0x69, 0x38, 0x75, 0xba, 0x03, 0xe6, 0xbd, 0xe6, 0x70,
+	0x4e, 0xb9, 0x2f, 0x9c, 0x49, 0xd1, 0xe9, 0x41, 0x08, 0x22, 0x10, 0xf6, 0x88, 0x33, 0xc9, 0x70,
+	0x56, 0x99, 0xec, 0x79, 0x93, 0x3d, 0x29, 0xee, 0x6e, 0xf7, 0x58, 0x8f, 0x29, 0x87, 0x33, 0xbb,
+	0x8a, 0xcc, 0xbb, 0x3b, 0x1e, 0x13, 0x43, 0x26, 0x48, 0x24, 0x44, 0x37, 0x5a, 0xca, 0x45, 0x77,
+	0x4e, 0x97, 0x0a, 0x70, 0x26, 0xc5, 0x2e, 0x48, 0x5a, 0x74, 0x3c, 0x16, 0x84, 0x5a, 0x5f, 0xd2,
+	0x4c, 0x1c, 0xa9, 0x4c, 0x7b, 0xef, 0xef, 0xa3, 0xd5, 0x16, 0xe5, 0x74, 0x28, 0x70, 0x13, 0x6d,
+	0x29, 0x2f, 0x89, 0x1c, 0x84, 0x0e, 0xd9, 0x38, 0x94, 0x96, 0x91, 0x37, 0x0a, 0xe9, 0x83, 0x1d,
+	0x5b, 0x67, 0xcf, 0xd2, 0x6c, 0x9d, 0x66, 0x57, 0x58, 0x10, 0x96, 0x53, 0x6f, 0xde, 0x3d, 0x4a,
+	0xb8, 0x9b, 0x6a, 0xad, 0xab, 0x96, 0x96, 0xd4, 0x4a, 0xfc, 0x1c, 0xad, 0x6b, 0xd4, 0x88, 0x0d,
+	0x02, 0x6f, 0x6a, 0xad, 0xe4, 0x8d, 0xc2, 0xc6, 0xc1, 0x13, 0xfb, 0x7f, 0x37, 0xc0, 0x8e, 0xd6,
+	0xb6, 0x94, 0xd5, 0xcd, 0xf0, 0xb9, 0x3b, 0xfc, 0x23, 0xb2, 0x60, 0x38, 0x92, 0x53, 0xb2, 0xd0,
+	0x20, 0xa7, 0x32, 0x60, 0x56, 0x32, 0x6f, 0x14, 0xd6, 0xca, 0xc5, 0x59, 0x13, 0x7f, 0xbf, 0x7b,
+	0xf4, 0x49, 0xd4, 0xa6, 0xf0, 0x5f, 0xdb, 0x01, 0x73, 0x86, 0x54, 0xf6, 0xed, 0x06, 0xf4, 0xa8,
+	0x37, 0xad, 0x82, 0xf7, 0xe7, 0x1f, 0xfb, 0x48, 0x3f, 0x45, 0x15, 0x3c, 0x37, 0xab, 0x90, 0xe5,
+	0x9b, 0xb6, 0xdd, 0x19, 0x0f, 0x37, 0x6f, 0xba, 0x06, 0x4e, 0xe4, 0x85, 0x95, 0x52, 0x01, 0x5f,
+	0xe8, 0x80, 0xec, 0x87, 0x01, 0xf5, 0x50, 0xce, 0xa1, 0xeb, 0xa1, 0x74, 0xd3, 0xba, 0x7b, 0xe0,
+	0xed, 0x0b, 0xdc, 0x41, 0x1b, 0x73, 0xc0, 0x1e, 0x15, 0xd6, 0xbd, 0xbb, 0xb6, 0x9c, 0xb9, 0xe6,
+	0x1e, 0x51, 0x81, 0x1f, 0xa3, 0x0c, 0x8c, 0x98, 0xd7, 0x27, 0x03, 0x08, 0x7b, 0xb2, 0x6f, 0xad,
+	0xe6, 0x8d, 0x42, 0xca, 0x4d, 0xab, 0x5a, 0x43, 0x95, 0xf0, 0xd7, 0xe8, 0xe1, 0x00, 0xfc, 0x1e,
+	0x70, 0xa2, 0xaa, 0x82, 0x70, 0x90, 0x34, 0x08, 0xc1, 0xb7, 0xee, 0x2b, 0xf3, 0x76, 0xa4, 0xd6,
+	0x94, 0xe8, 0x6a, 0x0d, 0x97, 0x51, 0x7a, 0x44, 0xa7, 0x6c, 0x2c, 0xc9, 0x90, 0xf9, 0x60, 0x7d,
+	0xa4, 0x5e, 0xdb, 0xe3, 0x25, 0xaf, 0xad, 0xa5, 0x9c, 0xc7, 0xcc, 0x07, 0x17, 0x8d, 0xae, 0xaf,
+	0xf1, 0x3e, 0xc2, 0xe7, 0x81, 0xec, 0xf7, 0xd9, 0xc0, 0x0f, 0xc2, 0xde, 0xec, 0xd1, 0x03, 0xe6,
+	0x5b, 0x6b, 0x2a, 0x75, 0x73, 0x4e, 0x69, 0x29, 0x01, 0x3f, 0x43, 0x16, 0x87, 0x9f, 0xc6, 0x01,
+	0x07, 0x9f, 0x78, 0x2c, 0x14, 0xe3, 0x21, 0x70, 0xe2, 0xf5, 0x69, 0x10, 0x0a, 0x0b, 0xe5, 0x93,
+	0x85, 0x35, 0xf7, 0x61, 0xac, 0x57, 0xb4, 0x5c, 0x51, 0x2a, 0x06, 0x94, 0xbd, 0x5e, 0xa0, 0xf7,
+	0x59, 0xf4, 0x29, 0x07, 0x2b, 0x7d, 0xd7, 0x5d, 0xde, 0x8a, 0x79, 0xd1, 0xb1, 0x38, 0x99, 0xd1,
+	0x30, 0x41, 0xf8, 0x0c, 0x80, 0x70, 0xf0, 0x03, 0x0e, 0x9e, 0xd4, 0x19, 0x99, 0xbb, 0x66, 0x98,
+	0x67, 0x00, 0xae, 0x66, 0x45, 0x01, 0x1d, 0xb4, 0x31, 0x0b, 0xe8, 0x8e, 0x79, 0xa8, 0xe1, 0xeb,
+	0x77, 0x3e, 0x26, 0x67, 0x00, 0xe5, 0x31, 0x0f, 0x15, 0x78, 0xef, 0x9f, 0x24, 0xca, 0x1c, 0x45,
+	0x5f, 0xa0, 0x13, 0x49, 0x25, 0xe0, 0x6f, 0xd0, 0xea, 0x48, 0x8d, 0xbc, 0x9e, 0xed, 0x4f, 0x97,
+	0xbe, 0xd9, 0x99, 0x49, 0xcf, 0xb7, 0x5e, 0x82, 0x09, 0xda, 0x9c, 0xd0, 0x41, 0xe0, 0x53, 0xc9,
+	0xe2, 0xfd, 0x16, 0xd6, 0x4a, 0x3e, 0x59, 0x48, 0x1f, 0x7c, 0xb9, 0x84, 0xf3, 0x32, 0xf6, 0xeb,
+	0x31, 0x03, 0x8f, 0x71, 0x5f, 0x63, 0xcd, 0xc9, 0xa2, 0x28, 0x70, 0x07, 0xad, 0x47, 0xa7, 0x3a,
+	0x86, 0x27, 0x6f, 0x85, 0xab, 0xa3, 0xfb, 0x9f, 0x84, 0xb8, 0xe7, 0x68, 0x3c, 0x62, 0x70, 0x1b,
+	0x3d, 0xa0, 0x9e, 0xc7, 0xc7, 0xe0, 0x5f, 0xa3, 0x53, 0x0a, 0xfd, 0xd9, 0x12, 0x74, 0x29, 0x72,
+	0x2f, 0x32, 0x37, 0xe8, 0x42, 0x15, 0xbf, 0x44, 0xa6, 0x3a, 0xcd, 0x30, 0xb8, 0xc1, 0xde, 0xbb,
+	0x15, 0xdb, 0xd1, 0xf6, 0x88, 0xa0, 0xb1, 0x0f, 0xce, 0x17, 0xaa, 0x02, 0xd7, 0x10, 0x9a, 0x1d,
+	0x07, 0xc9, 0x24, 0x1d, 0x08, 0x35, 0xda, 0xe9, 0x83, 0xfc, 0x12, 0xe2, 0x21, 0x40, 0x5b, 0xf9,
+	0x34, 0x6c, 0xed, 0x2c, 0x2e, 0x3c, 0xfd, 0xd5, 0x40, 0x99, 0xf9, 0x0f, 0x2b, 0xfe, 0x18, 0x6d,
+	0xb9, 0xb5, 0x4e, 0xc9, 0xad, 0x92, 0x56, 0xb3, 0x51, 0xaf, 0x9c, 0x92, 0xc3, 0xfa, 0xab, 0x5a,
+	0xd5, 0x4c, 0xe0, 0xcf, 0xd1, 0xde, 0xa2, 0x50, 0x3b, 0x6e, 0xb5, 0x4f, 0x49, 0xb9, 0xd1, 0xac,
+	0x7c, 0x47, 0x0e, 0xdd, 0x52, 0xa5, 0x5d, 0x6f, 0x7e, 0x6f, 0x1a, 0xd8, 0x42, 0xdb, 0x8b, 0xbe,
+	0x56, 0xcd, 0x25, 0xed, 0x57, 0xe6, 0x0a, 0xde, 0x41, 0xd9, 0x0f, 0x95, 0xa3, 0xd2, 0x89, 0x99,
+	0xdc, 0x4d, 0xfd, 0xf2, 0x7b, 0x2e, 0xf1, 0xf4, 0x5b, 0x84, 0x6e, 0xbe, 0x16, 0x78, 0x1b, 0x99,
+	0xad, 0xd2, 0x69, 0xf3, 0x45, 0x9b, 0x1c, 0x37, 0xab, 0x35, 0xd2, 0x7a, 0x71, 0xf2, 0xdc, 0x4c,
+	0xe0, 0x2c, 0xda, 0x9c, 0xaf, 0x56, 0x1a, 0xa5, 0xfa, 0xb1, 0x69, 0x44, 0x80, 0xb2, 0xfb, 0xe6,
+	0x32, 0x67, 0xbc, 0xbd, 0xcc, 0x19, 0xef, 0x2f, 0x73, 0xc6, 0x6f, 0x57, 0xb9, 0xc4, 0xdb, 0xab,
+	0x5c, 0xe2, 0xaf, 0xab, 0x5c, 0xe2, 0x87, 0x67, 0xbd, 0x40, 0xf6, 0xc7, 0x5d, 0xdb, 0x63, 0x43,
+	0x47, 0x6d, 0xd2, 0xfe, 0xc5, 0xf4, 0x67, 0x7d, 0x35, 0xe2, 0x6c, 0x12, 0xf8, 0xc0, 0x9d, 0x8b,
+	0xc5, 0x9f, 0xa1, 0x9c, 0x8e, 0x40, 0x74, 0x57, 0xd5, 0x8f, 0xf0, 0xab, 0x7f, 0x07, 0x00, 0xa5,
+	0x2c, 0x10, 0xfc, 0xbc, 0x07, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -165,6 +398,100 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.FeeBurnShare.Size()
+		i -= size
+		if _, err := m.FeeBurnShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.FeeRedirectShare.Size()
+		i -= size
+		if _, err := m.FeeRedirectShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.ConsumerRewardShare.Size()
+		i -= size
+		if _, err := m.ConsumerRewardShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.RequiredConsumerChains) > 0 {
+		for iNdEx := len(m.RequiredConsumerChains) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequiredConsumerChains[iNdEx])
+			copy(dAtA[i:], m.RequiredConsumerChains[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.RequiredConsumerChains[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.WithholdingPeriod != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.WithholdingPeriod))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.PayoutMode != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PayoutMode))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.LedgerEpochsRetained != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LedgerEpochsRetained))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EpochLength != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.RewardPerGas.Size()
+		i -= size
+		if _, err := m.RewardPerGas.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.RewardPerTx.Size()
+		i -= size
+		if _, err := m.RewardPerTx.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.EmptyBlockRewardRatio.Size()
+		i -= size
+		if _, err := m.EmptyBlockRewardRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.RewardPolicy != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RewardPolicy))
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size, err := m.BlockRewardAmount.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -198,6 +525,72 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.FeeTotals.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.WithheldRewards) > 0 {
+		for iNdEx := len(m.WithheldRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithheldRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AccruedRewards) > 0 {
+		for iNdEx := len(m.AccruedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccruedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.EpochRewards) > 0 {
+		for iNdEx := len(m.EpochRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ValidatorRewards) > 0 {
+		for iNdEx := len(m.ValidatorRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -230,6 +623,39 @@ func (m *Params) Size() (n int) {
 	_ = l
 	l = m.BlockRewardAmount.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.RewardPolicy != 0 {
+		n += 1 + sovGenesis(uint64(m.RewardPolicy))
+	}
+	l = m.EmptyBlockRewardRatio.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.RewardPerTx.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.RewardPerGas.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.EpochLength != 0 {
+		n += 1 + sovGenesis(uint64(m.EpochLength))
+	}
+	if m.LedgerEpochsRetained != 0 {
+		n += 1 + sovGenesis(uint64(m.LedgerEpochsRetained))
+	}
+	if m.PayoutMode != 0 {
+		n += 1 + sovGenesis(uint64(m.PayoutMode))
+	}
+	if m.WithholdingPeriod != 0 {
+		n += 1 + sovGenesis(uint64(m.WithholdingPeriod))
+	}
+	if len(m.RequiredConsumerChains) > 0 {
+		for _, s := range m.RequiredConsumerChains {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.ConsumerRewardShare.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.FeeRedirectShare.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.FeeBurnShare.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -241,6 +667,32 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ValidatorRewards) > 0 {
+		for _, e := range m.ValidatorRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EpochRewards) > 0 {
+		for _, e := range m.EpochRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AccruedRewards) > 0 {
+		for _, e := range m.AccruedRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WithheldRewards) > 0 {
+		for _, e := range m.WithheldRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.FeeTotals.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -312,61 +764,64 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPolicy", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
+			m.RewardPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardPolicy |= RewardPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmptyBlockRewardRatio", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.EmptyBlockRewardRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerTx", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -376,22 +831,519 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RewardPerTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerGas", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardPerGas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LedgerEpochsRetained", wireType)
+			}
+			m.LedgerEpochsRetained = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LedgerEpochsRetained |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutMode", wireType)
+			}
+			m.PayoutMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayoutMode |= PayoutMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithholdingPeriod", wireType)
+			}
+			m.WithholdingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithholdingPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredConsumerChains", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredConsumerChains = append(m.RequiredConsumerChains, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerRewardShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConsumerRewardShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRedirectShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeRedirectShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBurnShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeBurnShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorRewards = append(m.ValidatorRewards, ValidatorRewardRecord{})
+			if err := m.ValidatorRewards[len(m.ValidatorRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochRewards = append(m.EpochRewards, EpochValidatorRewards{})
+			if err := m.EpochRewards[len(m.EpochRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccruedRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccruedRewards = append(m.AccruedRewards, AccruedRewards{})
+			if err := m.AccruedRewards[len(m.AccruedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithheldRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithheldRewards = append(m.WithheldRewards, WithheldReward{})
+			if err := m.WithheldRewards[len(m.WithheldRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTotals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeTotals.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1,8 +1,94 @@
 package types
 
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	ModuleName = "blockrewards"
 	StoreKey   = ModuleName
+	// TStoreKey is the transient store holding per-block usage figures.
+	TStoreKey = "transient_" + ModuleName
 	// ParamStoreKeyBlockRewardAmount is the key for the block reward amount in the param store.
 	ParamStoreKeyBlockRewardAmount = "BlockRewardAmount"
-)
\ No newline at end of file
+)
+
+// ParamsKey is the store key of the module parameters.
+var ParamsKey = []byte("Params")
+
+// BlockTxCountKey is the transient store key for the number of txs included
+// in the block being finalized.
+var BlockTxCountKey = []byte("BlockTxCount")
+
+// Rewards ledger
+// ValidatorRewardsPrefix || len(valAddr) || valAddr            -> ValidatorRewardRecord
+// EpochRewardsPrefix || epoch (8 bytes BE) || len(valAddr) || valAddr -> EpochValidatorRewards
+var (
+	ValidatorRewardsPrefix = []byte{0x01}
+	EpochRewardsPrefix     = []byte{0x02}
+)
+
+// Claim-based payouts
+// AccruedRewardsPrefix || len(valAddr) || valAddr -> AccruedRewards
+// TotalAccruedKey                                 -> AccruedRewards (no validator), sum of all of the above
+var (
+	AccruedRewardsPrefix = []byte{0x03}
+	TotalAccruedKey      = []byte{0x04}
+)
+
+// Withholding
+// WithheldRewardsPrefix || release height (8 bytes BE) || len(valAddr) || valAddr -> WithheldReward
+// TotalWithheldKey                                                              -> AccruedRewards (no validator)
+// WithheldByValidatorPrefix || len(valAddr) || valAddr || release height       -> empty, index of the above
+var (
+	WithheldRewardsPrefix     = []byte{0x05}
+	TotalWithheldKey          = []byte{0x06}
+	WithheldByValidatorPrefix = []byte{0x08}
+)
+
+// Fee routing
+// FeeTotalsKey -> FeeTotals, the cumulative fees redirected and burned
+var FeeTotalsKey = []byte{0x07}
+
+func ValidatorRewardsKey(valAddr sdk.ValAddress) []byte {
+	return append(append([]byte{}, ValidatorRewardsPrefix...), address.MustLengthPrefix(valAddr)...)
+}
+
+// EpochRewardsEpochPrefix returns the prefix of all ledger entries of an epoch.
+func EpochRewardsEpochPrefix(epoch uint64) []byte {
+	k := make([]byte, 0, len(EpochRewardsPrefix)+8)
+	k = append(k, EpochRewardsPrefix...)
+	return binary.BigEndian.AppendUint64(k, epoch)
+}
+
+func EpochRewardsKey(epoch uint64, valAddr sdk.ValAddress) []byte {
+	return append(EpochRewardsEpochPrefix(epoch), address.MustLengthPrefix(valAddr)...)
+}
+
+func AccruedRewardsKey(valAddr sdk.ValAddress) []byte {
+	return append(append([]byte{}, AccruedRewardsPrefix...), address.MustLengthPrefix(valAddr)...)
+}
+
+// WithheldRewardsHeightPrefix returns the prefix of all rewards released at height.
+func WithheldRewardsHeightPrefix(releaseHeight int64) []byte {
+	k := make([]byte, 0, len(WithheldRewardsPrefix)+8)
+	k = append(k, WithheldRewardsPrefix...)
+	return binary.BigEndian.AppendUint64(k, uint64(releaseHeight))
+}
+
+func WithheldRewardsKey(releaseHeight int64, valAddr sdk.ValAddress) []byte {
+	return append(WithheldRewardsHeightPrefix(releaseHeight), address.MustLengthPrefix(valAddr)...)
+}
+
+// WithheldByValidatorValPrefix returns the index prefix of all rewards withheld
+// for the validator.
+func WithheldByValidatorValPrefix(valAddr sdk.ValAddress) []byte {
+	return append(append([]byte{}, WithheldByValidatorPrefix...), address.MustLengthPrefix(valAddr)...)
+}
+
+func WithheldByValidatorKey(valAddr sdk.ValAddress, releaseHeight int64) []byte {
+	return binary.BigEndian.AppendUint64(WithheldByValidatorValPrefix(valAddr), uint64(releaseHeight))
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateBasic for MsgClaimBlockRewards
+func (m *MsgClaimBlockRewards) ValidateBasic() error {
+	claimer, err := sdk.AccAddressFromBech32(m.Claimer)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "claimer: %v", err)
+	}
+	valAddr, err := sdk.ValAddressFromBech32(m.ValidatorAddress)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "validator_address: %v", err)
+	}
+	if !claimer.Equals(sdk.AccAddress(valAddr)) {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "claimer must be the validator operator account")
+	}
+	return nil
+}
+
+// ValidateBasic for MsgUpdateParams
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "authority: %v", err)
+	}
+	if err := m.Params.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
@@ -2,15 +2,33 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+const (
+	// DefaultEpochLength is roughly one day of 6s blocks.
+	DefaultEpochLength uint64 = 14400
+	// DefaultLedgerEpochsRetained keeps one week of daily epochs besides the current one.
+	DefaultLedgerEpochsRetained uint64 = 7
+)
+
 // DefaultParams returns the default parameters for the blockrewards module.
 func DefaultParams() Params {
 	return Params{
-		BlockRewardAmount: sdk.NewCoin("stake", math.NewInt(100000)),
+		BlockRewardAmount:     sdk.NewCoin("stake", math.NewInt(100000)),
+		RewardPolicy:          REWARD_POLICY_FIXED,
+		EmptyBlockRewardRatio: math.LegacyZeroDec(),
+		RewardPerTx:           math.ZeroInt(),
+		RewardPerGas:          math.LegacyZeroDec(),
+		EpochLength:           DefaultEpochLength,
+		LedgerEpochsRetained:  DefaultLedgerEpochsRetained,
+		PayoutMode:            PAYOUT_MODE_CLAIM,
+		ConsumerRewardShare:   math.LegacyZeroDec(),
+		FeeRedirectShare:      math.LegacyZeroDec(),
+		FeeBurnShare:          math.LegacyZeroDec(),
 	}
 }
 
@@ -20,5 +38,115 @@ func (p Params) Validate() error {
 	if p.BlockRewardAmount.IsNegative() {
 		return fmt.Errorf("block reward amount cannot be negative: %s", p.BlockRewardAmount)
 	}
+	if _, ok := RewardPolicy_name[int32(p.RewardPolicy)]; !ok {
+		return fmt.Errorf("unknown reward policy: %d", p.RewardPolicy)
+	}
+	if !p.EmptyBlockRewardRatio.IsNil() {
+		if p.EmptyBlockRewardRatio.IsNegative() || p.EmptyBlockRewardRatio.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("empty block reward ratio must be within [0, 1]: %s", p.EmptyBlockRewardRatio)
+		}
+	}
+	if !p.RewardPerTx.IsNil() && p.RewardPerTx.IsNegative() {
+		return fmt.Errorf("reward per tx cannot be negative: %s", p.RewardPerTx)
+	}
+	if !p.RewardPerGas.IsNil() && p.RewardPerGas.IsNegative() {
+		return fmt.Errorf("reward per gas cannot be negative: %s", p.RewardPerGas)
+	}
+	if _, ok := PayoutMode_name[int32(p.PayoutMode)]; !ok {
+		return fmt.Errorf("unknown payout mode: %d", p.PayoutMode)
+	}
+	if !p.ConsumerRewardShare.IsNil() {
+		if p.ConsumerRewardShare.IsNegative() || p.ConsumerRewardShare.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("consumer reward share must be within [0, 1]: %s", p.ConsumerRewardShare)
+		}
+	}
+	feeRedirectShare, feeBurnShare := decOrZero(p.FeeRedirectShare), decOrZero(p.FeeBurnShare)
+	if feeRedirectShare.IsNegative() || feeBurnShare.IsNegative() {
+		return fmt.Errorf("fee shares cannot be negative: redirect %s, burn %s", feeRedirectShare, feeBurnShare)
+	}
+	if feeRedirectShare.Add(feeBurnShare).GT(math.LegacyOneDec()) {
+		return fmt.Errorf("fee redirect share %s and burn share %s add up to more than 1", feeRedirectShare, feeBurnShare)
+	}
+	seen := make(map[string]bool, len(p.RequiredConsumerChains))
+	for _, chainID := range p.RequiredConsumerChains {
+		if strings.TrimSpace(chainID) == "" {
+			return fmt.Errorf("required consumer chain id cannot be blank")
+		}
+		if seen[chainID] {
+			return fmt.Errorf("duplicate required consumer chain id: %s", chainID)
+		}
+		seen[chainID] = true
+	}
+	if p.LedgerEpochsRetained > 0 && p.EpochLength == 0 {
+		return fmt.Errorf("epoch length must be positive when ledger epochs are retained")
+	}
 	return nil
 }
+
+// Epoch returns the rewards ledger epoch a block height belongs to. Params
+// stored before epochs existed fall back to DefaultEpochLength.
+func (p Params) Epoch(height int64) uint64 {
+	length := p.EpochLength
+	if length == 0 {
+		length = DefaultEpochLength
+	}
+	if height < 0 {
+		return 0
+	}
+	return uint64(height) / length
+}
+
+// BlockReward returns the reward owed for a finalized block that included
+// txCount transactions and consumed gasUsed gas, according to the selected
+// reward policy. The result never exceeds BlockRewardAmount.
+func (p Params) BlockReward(txCount, gasUsed uint64) sdk.Coin {
+	full := p.BlockRewardAmount
+	amount := full.Amount
+
+	switch p.RewardPolicy {
+	case REWARD_POLICY_EMPTY_BLOCK_FRACTION:
+		if txCount == 0 {
+			amount = decOrZero(p.EmptyBlockRewardRatio).MulInt(full.Amount).TruncateInt()
+		}
+	case REWARD_POLICY_PER_TX:
+		perTx := p.RewardPerTx
+		if perTx.IsNil() {
+			perTx = math.ZeroInt()
+		}
+		amount = perTx.Mul(math.NewIntFromUint64(txCount))
+	case REWARD_POLICY_PER_GAS:
+		amount = decOrZero(p.RewardPerGas).MulInt(math.NewIntFromUint64(gasUsed)).TruncateInt()
+	}
+
+	return sdk.NewCoin(full.Denom, math.MinInt(amount, full.Amount))
+}
+
+// ConsumerShare splits a block reward into the part routed to consumer chain
+// validators and the part left for the proposer.
+func (p Params) ConsumerShare(reward sdk.Coins) (consumer, proposer sdk.Coins) {
+	share := decOrZero(p.ConsumerRewardShare)
+	consumer = sdk.NewCoins()
+	for _, c := range reward {
+		consumer = consumer.Add(sdk.NewCoin(c.Denom, share.MulInt(c.Amount).TruncateInt()))
+	}
+	return consumer, reward.Sub(consumer...)
+}
+
+// FeeShares splits the fees deducted from a tx into the part redirected to
+// the blockrewards module account and the part burned. Only fees in the block
+// reward denom are routed, other denoms are left to the fee collector.
+func (p Params) FeeShares(fees sdk.Coins) (redirect, burn sdk.Coins) {
+	amount := fees.AmountOf(p.BlockRewardAmount.Denom)
+	redirect = sdk.NewCoins(sdk.NewCoin(p.BlockRewardAmount.Denom, decOrZero(p.FeeRedirectShare).MulInt(amount).TruncateInt()))
+	burn = sdk.NewCoins(sdk.NewCoin(p.BlockRewardAmount.Denom, decOrZero(p.FeeBurnShare).MulInt(amount).TruncateInt()))
+	return redirect, burn
+}
+
+// decOrZero treats params decoded from state written before a field existed
+// as zero instead of panicking on a nil decimal.
+func decOrZero(d math.LegacyDec) math.LegacyDec {
+	if d.IsNil() {
+		return math.LegacyZeroDec()
+	}
+	return d
+}
@@ -0,0 +1,138 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestBlockReward(t *testing.T) {
+	full := sdk.NewCoin("stake", math.NewInt(1000))
+
+	cases := []struct {
+		name     string
+		params   Params
+		txCount  uint64
+		gasUsed  uint64
+		expected math.Int
+	}{
+		{
+			name:     "fixed pays full reward for empty block",
+			params:   Params{BlockRewardAmount: full},
+			expected: math.NewInt(1000),
+		},
+		{
+			name: "empty block fraction pays ratio for empty block",
+			params: Params{
+				BlockRewardAmount:     full,
+				RewardPolicy:          REWARD_POLICY_EMPTY_BLOCK_FRACTION,
+				EmptyBlockRewardRatio: math.LegacyNewDecWithPrec(25, 2),
+			},
+			expected: math.NewInt(250),
+		},
+		{
+			name: "empty block fraction pays full reward when block has txs",
+			params: Params{
+				BlockRewardAmount:     full,
+				RewardPolicy:          REWARD_POLICY_EMPTY_BLOCK_FRACTION,
+				EmptyBlockRewardRatio: math.LegacyZeroDec(),
+			},
+			txCount:  1,
+			expected: math.NewInt(1000),
+		},
+		{
+			name: "per tx",
+			params: Params{
+				BlockRewardAmount: full,
+				RewardPolicy:      REWARD_POLICY_PER_TX,
+				RewardPerTx:       math.NewInt(30),
+			},
+			txCount:  3,
+			expected: math.NewInt(90),
+		},
+		{
+			name: "per tx is capped at block reward amount",
+			params: Params{
+				BlockRewardAmount: full,
+				RewardPolicy:      REWARD_POLICY_PER_TX,
+				RewardPerTx:       math.NewInt(300),
+			},
+			txCount:  4,
+			expected: math.NewInt(1000),
+		},
+		{
+			name: "per gas truncates",
+			params: Params{
+				BlockRewardAmount: full,
+				RewardPolicy:      REWARD_POLICY_PER_GAS,
+				RewardPerGas:      math.LegacyNewDecWithPrec(1, 3),
+			},
+			gasUsed:  123_456,
+			expected: math.NewInt(123),
+		},
+		{
+			name: "unset fields from old state pay nothing",
+			params: Params{
+				BlockRewardAmount: full,
+				RewardPolicy:      REWARD_POLICY_PER_GAS,
+			},
+			gasUsed:  123_456,
+			expected: math.ZeroInt(),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			reward := tc.params.BlockReward(tc.txCount, tc.gasUsed)
+			require.Equal(t, full.Denom, reward.Denom)
+			require.Equal(t, tc.expected.String(), reward.Amount.String())
+		})
+	}
+}
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, DefaultParams().Validate())
+
+	p := DefaultParams()
+	p.EmptyBlockRewardRatio = math.LegacyNewDec(2)
+	require.Error(t, p.Validate())
+
+	p = DefaultParams()
+	p.RewardPolicy = RewardPolicy(42)
+	require.Error(t, p.Validate())
+
+	p = DefaultParams()
+	p.RewardPerTx = math.NewInt(-1)
+	require.Error(t, p.Validate())
+
+	p = DefaultParams()
+	p.ConsumerRewardShare = math.LegacyNewDecWithPrec(11, 1)
+	require.Error(t, p.Validate())
+
+	p = DefaultParams()
+	p.FeeRedirectShare = math.LegacyNewDecWithPrec(6, 1)
+	p.FeeBurnShare = math.LegacyNewDecWithPrec(5, 1)
+	require.Error(t, p.Validate())
+
+	p = DefaultParams()
+	p.FeeBurnShare = math.LegacyNewDecWithPrec(-1, 1)
+	require.Error(t, p.Validate())
+}
+
+func TestFeeShares(t *testing.T) {
+	p := DefaultParams()
+	p.FeeRedirectShare = math.LegacyNewDecWithPrec(3, 1)
+	p.FeeBurnShare = math.LegacyNewDecWithPrec(25, 2)
+	denom := p.BlockRewardAmount.Denom
+
+	redirect, burn := p.FeeShares(sdk.NewCoins(sdk.NewInt64Coin(denom, 1001), sdk.NewInt64Coin("uatom", 500)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom, 300)), redirect)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom, 250)), burn)
+
+	redirect, burn = DefaultParams().FeeShares(sdk.NewCoins(sdk.NewInt64Coin(denom, 1000)))
+	require.True(t, redirect.IsZero())
+	require.True(t, burn.IsZero())
+}
@@ -0,0 +1,1980 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: maany/blockrewards/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4262179fd80943b0, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4262179fd80943b0, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type QueryValidatorRewardsRequest struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *QueryValidatorRewardsRequest) Reset()         { *m = QueryValidatorRewardsRequest{} }
+func (m *QueryValidatorRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorRewardsRequest) ProtoMessage()    {}
+func (*QueryValidatorRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4262179fd80943b0, []int{2}
+}
+func (m *QueryValidatorRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorRewardsRequest.Merge(m, src)
+}
+func (m *QueryValidatorRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorRewardsRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+type QueryValidatorRewardsResponse struct {
+	Total ValidatorRewardRecord `protobuf:"bytes,1,opt,name=total,proto3" json:"total"`
+	// epochs holds the validator's rewards for every retained epoch it earned in,
+	// oldest first.
+	Epochs       []EpochValidatorRewards `protobuf:"bytes,2,rep,name=epochs,proto3" json:"epochs"`
+	CurrentEpoch uint64                  `protobuf:"varint,3,opt,name=current_epoch,json=currentEpoch,proto3" json:"current_epoch,omitempty"`
+	// accrued is the reward the validator can currently claim.
+	Accrued AccruedRewards `protobuf:"bytes,4,opt,name=accrued,proto3" json:"accrued"`
+	// withheld lists the rewards still held back for the validator.
+	Withheld []WithheldReward `protobuf:"bytes,5,rep,name=withheld,proto3" json:"withheld"`
+}
+
+func (m *QueryValidatorRewardsResponse) Reset()         { *m = QueryValidatorRewardsResponse{} }
+func (m *QueryValidatorRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorRewardsResponse) ProtoMessage()    {}
+func (*QueryValidatorRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4262179fd80943b0, []int{3}
+}
+func (m *QueryValidatorRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorRewardsResponse.Merge(m, src)
+}
+func (m *QueryValidatorRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorRewardsResponse) GetTotal() ValidatorRewardRecord {
+	if m != nil {
+		return m.Total
+	}
+	return ValidatorRewardRecord{}
+}
+
+func (m *QueryValidatorRewardsResponse) GetEpochs() []EpochValidatorRewards {
+	if m != nil {
+		return m.Epochs
+	}
+	return nil
+}
+
+func (m *QueryValidatorRewardsResponse) GetCurrentEpoch() uint64 {
+	if m != nil {
+		return m.CurrentEpoch
+	}
+	return 0
+}
+
+func (m *QueryValidatorRewardsResponse) GetAccrued() AccruedRewards {
+	if m != nil {
+		return m.Accrued
+	}
+	return AccruedRewards{}
+}
+
+func (m *QueryValidatorRewardsResponse) GetWithheld() []WithheldReward {
+	if m != nil {
+		return m.Withheld
+	}
+	return nil
+}
+
+type QueryTopEarnersRequest struct {
+	// epoch to rank; ignored when all_time is set. Defaults to the current epoch.
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// all_time ranks validators by their cumulative rewards instead.
+	AllTime bool `protobuf:"varint,2,opt,name=all_time,json=allTime,proto3" json:"all_time,omitempty"`
+	// limit caps the number of returned validators, 10 if unset.
+	Limit uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *QueryTopEarnersRequest) Reset()         { *m = QueryTopEarnersRequest{} }
+func (m *QueryTopEarnersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTopEarnersRequest) ProtoMessage()    {}
+func (*QueryTopEarnersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4262179fd80943b0, []int{4}
+}
+func (m *QueryTopEarnersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTopEarnersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTopEarnersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTopEarnersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopEarnersRequest.Merge(m, src)
+}
+func (m *QueryTopEarnersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTopEarnersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopEarnersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTopEarnersRequest proto.InternalMessageInfo
+
+func (m *QueryTopEarnersRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *QueryTopEarnersRequest) GetAllTime() bool {
+	if m != nil {
+		return m.AllTime
+	}
+	return false
+}
+
+func (m *QueryTopEarnersRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type QueryTopEarnersResponse struct {
+	Epoch   uint64                  `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Earners []EpochValidatorRewards `protobuf:"bytes,2,rep,name=earners,proto3" json:"earners"`
+}
+
+func (m *QueryTopEarnersResponse) Reset()         { *m = QueryTopEarnersResponse{} }
+func (m *QueryTopEarnersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTopEarnersResponse) ProtoMessage()    {}
+func (*QueryTopEarnersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4262179fd80943b0, []int{5}
+}
+func (m *QueryTopEarnersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTopEarnersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTopEarnersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTopEarnersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopEarnersResponse.Merge(m, src)
+}
+func (m *QueryTopEarnersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTopEarnersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopEarnersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTopEarnersResponse proto.InternalMessageInfo
+
+func (m *QueryTopEarnersResponse) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *QueryTopEarnersResponse) GetEarners() []EpochValidatorRewards {
+	if m != nil {
+		return m.Earners
+	}
+	return nil
+}
+
+type QueryFeeTotalsRequest struct {
+}
+
+func (m *QueryFeeTotalsRequest) Reset()         { *m = QueryFeeTotalsRequest{} }
+func (m *QueryFeeTotalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeTotalsRequest) ProtoMessage()    {}
+func (*QueryFeeTotalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4262179fd80943b0, []int{6}
+}
+func (m *QueryFeeTotalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeTotalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeTotalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeTotalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeTotalsRequest.Merge(m, src)
+}
+func (m *QueryFeeTotalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeTotalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeTotalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeTotalsRequest proto.InternalMessageInfo
+
+type QueryFeeTotalsResponse struct {
+	FeeTotals FeeTotals `protobuf:"bytes,1,opt,name=fee_totals,json=feeTotals,proto3" json:"fee_totals"`
+}
+
+func (m *QueryFeeTotalsResponse) Reset()         { *m = QueryFeeTotalsResponse{} }
+func (m *QueryFeeTotalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeTotalsResponse) ProtoMessage()    {}
+func (*QueryFeeTotalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4262179fd80943b0, []int{7}
+}
+func (m *QueryFeeTotalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeTotalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeTotalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeTotalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeTotalsResponse.Merge(m, src)
+}
+func (m *QueryFeeTotalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeTotalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeTotalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeTotalsResponse proto.InternalMessageInfo
+
+func (m *QueryFeeTotalsResponse) GetFeeTotals() FeeTotals {
+	if m != nil {
+		return m.FeeTotals
+	}
+	return FeeTotals{}
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "maany.blockrewards.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "maany.blockrewards.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryValidatorRewardsRequest)(nil), "maany.blockrewards.v1.QueryValidatorRewardsRequest")
+	proto.RegisterType((*QueryValidatorRewardsResponse)(nil), "maany.blockrewards.v1.QueryValidatorRewardsResponse")
+	proto.RegisterType((*QueryTopEarnersRequest)(nil), "maany.blockrewards.v1.QueryTopEarnersRequest")
+	proto.RegisterType((*QueryTopEarnersResponse)(nil), "maany.blockrewards.v1.QueryTopEarnersResponse")
+	proto.RegisterType((*QueryFeeTotalsRequest)(nil), "maany.blockrewards.v1.QueryFeeTotalsRequest")
+	proto.RegisterType((*QueryFeeTotalsResponse)(nil), "maany.blockrewards.v1.QueryFeeTotalsResponse")
+}
+
+func init() { proto.RegisterFile("maany/blockrewards/v1/query.proto", fileDescriptor_4262179fd80943b0) }
+
+var fileDescriptor_4262179fd80943b0 = []byte{
+	// 722 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x41, 0x4f, 0x13, 0x41,
+	0x14, 0xee, 0x16, 0x5a, 0x60, 0x94, 0x04, 0x47, 0x90, 0xd2, 0xd0, 0x52, 0x16, 0x49, 0x80, 0xd0,
+	0xdd, 0x00, 0x1e, 0x4c, 0x8c, 0x31, 0x90, 0x54, 0x8d, 0x31, 0x46, 0x57, 0xa2, 0x89, 0x97, 0xcd,
+	0xb0, 0x3b, 0x6c, 0x37, 0xee, 0xee, 0x2c, 0xb3, 0xd3, 0x42, 0x35, 0x5c, 0x3c, 0xe8, 0xd5, 0x84,
+	0x83, 0x7f, 0xc4, 0x9f, 0xe0, 0x81, 0x23, 0xd1, 0x8b, 0x27, 0x63, 0xc0, 0x93, 0xbf, 0xc2, 0x74,
+	0x66, 0x76, 0x81, 0xb6, 0x5b, 0x6b, 0xbc, 0xed, 0xbc, 0xf9, 0xde, 0xf7, 0x7d, 0x6f, 0xe6, 0xbd,
+	0x59, 0x30, 0xef, 0x23, 0x14, 0xb4, 0xf4, 0x1d, 0x8f, 0x58, 0xaf, 0x29, 0xde, 0x47, 0xd4, 0x8e,
+	0xf4, 0xe6, 0x9a, 0xbe, 0xd7, 0xc0, 0xb4, 0xa5, 0x85, 0x94, 0x30, 0x02, 0xa7, 0x38, 0x44, 0xbb,
+	0x08, 0xd1, 0x9a, 0x6b, 0xc5, 0x59, 0x87, 0x10, 0xc7, 0xc3, 0x3a, 0x0a, 0x5d, 0x1d, 0x05, 0x01,
+	0x61, 0x88, 0xb9, 0x24, 0x88, 0x44, 0x52, 0x71, 0xd2, 0x21, 0x0e, 0xe1, 0x9f, 0x7a, 0xfb, 0x4b,
+	0x46, 0x67, 0x2c, 0x12, 0xf9, 0x24, 0x32, 0xc5, 0x86, 0x58, 0xc8, 0xad, 0x85, 0xde, 0x46, 0x1c,
+	0x1c, 0xe0, 0xc8, 0xfd, 0x0b, 0x28, 0x76, 0xc5, 0x41, 0xea, 0x24, 0x80, 0xcf, 0xda, 0xf6, 0x9f,
+	0x22, 0x8a, 0xfc, 0xc8, 0xc0, 0x7b, 0x0d, 0x1c, 0x31, 0xd5, 0x00, 0xd7, 0x2f, 0x45, 0xa3, 0x90,
+	0x04, 0x11, 0x86, 0x77, 0x40, 0x3e, 0xe4, 0x91, 0x82, 0x52, 0x51, 0x96, 0xae, 0xac, 0x97, 0xb4,
+	0x9e, 0xd5, 0x6a, 0x22, 0x6d, 0x6b, 0xf8, 0xf8, 0xc7, 0x5c, 0xc6, 0x90, 0x29, 0x6a, 0x00, 0x66,
+	0x39, 0xe7, 0x0b, 0xe4, 0xb9, 0x36, 0x62, 0x84, 0x1a, 0x22, 0x41, 0x6a, 0xc2, 0x27, 0xe0, 0x5a,
+	0x33, 0xde, 0x32, 0x91, 0x6d, 0x53, 0x1c, 0x09, 0x9d, 0xb1, 0xad, 0xf9, 0xaf, 0x9f, 0xab, 0x25,
+	0x79, 0x00, 0x49, 0xfa, 0xa6, 0x80, 0x3c, 0x67, 0xd4, 0x0d, 0x1c, 0x63, 0xa2, 0xd9, 0x11, 0x57,
+	0x7f, 0x67, 0x41, 0x29, 0x45, 0x50, 0x96, 0xf3, 0x10, 0xe4, 0x18, 0x61, 0xc8, 0x93, 0xd5, 0xac,
+	0xa6, 0x54, 0xd3, 0x91, 0x6f, 0x60, 0x8b, 0x50, 0x5b, 0x16, 0x27, 0x08, 0xe0, 0x23, 0x90, 0xc7,
+	0x21, 0xb1, 0xea, 0x51, 0x21, 0x5b, 0x19, 0xea, 0x43, 0x55, 0x6b, 0x83, 0x3a, 0xfd, 0xc4, 0xe7,
+	0x24, 0x18, 0xe0, 0x02, 0x18, 0xb7, 0x1a, 0x94, 0xe2, 0x80, 0x99, 0x3c, 0x52, 0x18, 0xaa, 0x28,
+	0x4b, 0xc3, 0xc6, 0x55, 0x19, 0xe4, 0x14, 0xb0, 0x06, 0x46, 0x90, 0x65, 0xd1, 0x06, 0xb6, 0x0b,
+	0xc3, 0xdc, 0xfc, 0x62, 0x8a, 0xe2, 0xa6, 0x40, 0x5d, 0x96, 0x8a, 0x73, 0xe1, 0x03, 0x30, 0xba,
+	0xef, 0xb2, 0x7a, 0x1d, 0x7b, 0x76, 0x21, 0x57, 0x19, 0xea, 0xc3, 0xf3, 0x52, 0xc2, 0x04, 0x91,
+	0xe4, 0x49, 0x92, 0x55, 0x13, 0xdc, 0xe0, 0x67, 0xbd, 0x4d, 0xc2, 0x1a, 0xa2, 0x01, 0xa6, 0xc9,
+	0xb5, 0x4e, 0x82, 0x9c, 0x28, 0x43, 0xe1, 0x65, 0x88, 0x05, 0x9c, 0x01, 0xa3, 0xc8, 0xf3, 0x4c,
+	0xe6, 0xfa, 0xb8, 0x90, 0xad, 0x28, 0x4b, 0xa3, 0xc6, 0x08, 0xf2, 0xbc, 0x6d, 0xd7, 0xc7, 0xed,
+	0x04, 0xcf, 0xf5, 0x5d, 0xc6, 0xeb, 0x1e, 0x37, 0xc4, 0x42, 0x3d, 0x04, 0xd3, 0x5d, 0x02, 0xf2,
+	0x1a, 0x7b, 0x2b, 0x3c, 0x06, 0x23, 0x58, 0x00, 0xff, 0xe3, 0x4e, 0x62, 0x0a, 0x75, 0x1a, 0x4c,
+	0x71, 0xf9, 0xfb, 0x18, 0x6f, 0xb7, 0x6f, 0x3c, 0x99, 0x94, 0xb8, 0xf0, 0x0b, 0x1b, 0xd2, 0x56,
+	0x0d, 0x80, 0x5d, 0x8c, 0x4d, 0xde, 0x20, 0xf1, 0xc0, 0x54, 0x52, 0x3c, 0x24, 0xd9, 0x52, 0x77,
+	0x6c, 0x37, 0x0e, 0xac, 0x7f, 0xc8, 0x81, 0x1c, 0x57, 0x80, 0xef, 0x15, 0x90, 0x17, 0x93, 0x05,
+	0x97, 0x53, 0x78, 0xba, 0x47, 0xb9, 0xb8, 0x32, 0x08, 0x54, 0x58, 0x56, 0x17, 0xdf, 0x7d, 0xfb,
+	0x75, 0x94, 0x9d, 0x83, 0x25, 0xbd, 0xf7, 0xd3, 0x21, 0x26, 0x19, 0x7e, 0x51, 0xc0, 0x44, 0xe7,
+	0x81, 0xc1, 0x8d, 0x7e, 0x3a, 0x29, 0x33, 0x5f, 0xbc, 0xf5, 0x6f, 0x49, 0xd2, 0x66, 0x8d, 0xdb,
+	0xbc, 0x07, 0xef, 0xa6, 0xd8, 0x4c, 0x9e, 0x82, 0x48, 0x7f, 0xdb, 0xf5, 0xa4, 0x1c, 0xc6, 0x0f,
+	0x20, 0xfc, 0xa4, 0x00, 0x70, 0xde, 0x4e, 0xb0, 0xda, 0xcf, 0x4b, 0x57, 0x5f, 0x17, 0xb5, 0x41,
+	0xe1, 0xd2, 0xf4, 0x0a, 0x37, 0x7d, 0x13, 0xaa, 0x29, 0xa6, 0x19, 0x09, 0x4d, 0xd9, 0x6d, 0xf0,
+	0x48, 0x01, 0x63, 0x49, 0x4b, 0xc0, 0xd5, 0x7e, 0x4a, 0x9d, 0x0d, 0x59, 0xac, 0x0e, 0x88, 0x96,
+	0xb6, 0x96, 0xb9, 0xad, 0x05, 0x38, 0x9f, 0x62, 0xeb, 0xbc, 0x85, 0xb7, 0x8c, 0xe3, 0xd3, 0xb2,
+	0x72, 0x72, 0x5a, 0x56, 0x7e, 0x9e, 0x96, 0x95, 0x8f, 0x67, 0xe5, 0xcc, 0xc9, 0x59, 0x39, 0xf3,
+	0xfd, 0xac, 0x9c, 0x79, 0x75, 0xdb, 0x71, 0x59, 0xbd, 0xb1, 0xa3, 0x59, 0xc4, 0x17, 0x34, 0xd5,
+	0x83, 0xd6, 0x1b, 0xf9, 0x15, 0x52, 0xd2, 0x74, 0x6d, 0x4c, 0xf5, 0x83, 0xcb, 0xdc, 0xac, 0x15,
+	0xe2, 0x68, 0x27, 0xcf, 0xff, 0x42, 0x1b, 0x7f, 0x06, 0x00, 0x7a, 0x38, 0x6f, 0xb5, 0x5a, 0x07,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns the current blockrewards parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ValidatorRewards returns the cumulative block rewards of a validator and
+	// its per-epoch rewards within the retained window.
+	ValidatorRewards(ctx context.Context, in *QueryValidatorRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorRewardsResponse, error)
+	// TopEarners returns the validators that earned the most block rewards,
+	// either in one epoch or since the ledger was started.
+	TopEarners(ctx context.Context, in *QueryTopEarnersRequest, opts ...grpc.CallOption) (*QueryTopEarnersResponse, error)
+	// FeeTotals returns the cumulative tx fees redirected to the blockrewards
+	// module account and burned.
+	FeeTotals(ctx context.Context, in *QueryFeeTotalsRequest, opts ...grpc.CallOption) (*QueryFeeTotalsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/maany.blockrewards.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorRewards(ctx context.Context, in *QueryValidatorRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorRewardsResponse, error) {
+	out := new(QueryValidatorRewardsResponse)
+	err := c.cc.Invoke(ctx, "/maany.blockrewards.v1.Query/ValidatorRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TopEarners(ctx context.Context, in *QueryTopEarnersRequest, opts ...grpc.CallOption) (*QueryTopEarnersResponse, error) {
+	out := new(QueryTopEarnersResponse)
+	err := c.cc.Invoke(ctx, "/maany.blockrewards.v1.Query/TopEarners", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeTotals(ctx context.Context, in *QueryFeeTotalsRequest, opts ...grpc.CallOption) (*QueryFeeTotalsResponse, error) {
+	out := new(QueryFeeTotalsResponse)
+	err := c.cc.Invoke(ctx, "/maany.blockrewards.v1.Query/FeeTotals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns the current blockrewards parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ValidatorRewards returns the cumulative block rewards of a validator and
+	// its per-epoch rewards within the retained window.
+	ValidatorRewards(context.Context, *QueryValidatorRewardsRequest) (*QueryValidatorRewardsResponse, error)
+	// TopEarners returns the validators that earned the most block rewards,
+	// either in one epoch or since the ledger was started.
+	TopEarners(context.Context, *QueryTopEarnersRequest) (*QueryTopEarnersResponse, error)
+	// FeeTotals returns the cumulative tx fees redirected to the blockrewards
+	// module account and burned.
+	FeeTotals(context.Context, *QueryFeeTotalsRequest) (*QueryFeeTotalsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorRewards(ctx context.Context, req *QueryValidatorRewardsRequest) (*QueryValidatorRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorRewards not implemented")
+}
+func (*UnimplementedQueryServer) TopEarners(ctx context.Context, req *QueryTopEarnersRequest) (*QueryTopEarnersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopEarners not implemented")
+}
+func (*UnimplementedQueryServer) FeeTotals(ctx context.Context, req *QueryFeeTotalsRequest) (*QueryFeeTotalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeTotals not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/maany.blockrewards.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/maany.blockrewards.v1.Query/ValidatorRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorRewards(ctx, req.(*QueryValidatorRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TopEarners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopEarnersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TopEarners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/maany.blockrewards.v1.Query/TopEarners",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TopEarners(ctx, req.(*QueryTopEarnersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeTotals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeTotalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeTotals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/maany.blockrewards.v1.Query/FeeTotals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeTotals(ctx, req.(*QueryFeeTotalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "maany.blockrewards.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ValidatorRewards",
+			Handler:    _Query_ValidatorRewards_Handler,
+		},
+		{
+			MethodName: "TopEarners",
+			Handler:    _Query_TopEarners_Handler,
+		},
+		{
+			MethodName: "FeeTotals",
+			Handler:    _Query_FeeTotals_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "maany/blockrewards/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Withheld) > 0 {
+		for iNdEx := len(m.Withheld) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withheld[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.Accrued.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.CurrentEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Epochs) > 0 {
+		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Epochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Total.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopEarnersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopEarnersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopEarnersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AllTime {
+		i--
+		if m.AllTime {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopEarnersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopEarnersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopEarnersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Earners) > 0 {
+		for iNdEx := len(m.Earners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Earners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeTotalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeTotalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeTotalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeTotalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeTotalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeTotalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FeeTotals.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Total.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Epochs) > 0 {
+		for _, e := range m.Epochs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.CurrentEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentEpoch))
+	}
+	l = m.Accrued.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Withheld) > 0 {
+		for _, e := range m.Withheld {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTopEarnersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	if m.AllTime {
+		n += 2
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryTopEarnersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	if len(m.Earners) > 0 {
+		for _, e := range m.Earners {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFeeTotalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeTotalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeeTotals.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Epochs = append(m.Epochs, EpochValidatorRewards{})
+			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpoch", wireType)
+			}
+			m.CurrentEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accrued", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Accrued.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withheld", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withheld = append(m.Withheld, WithheldReward{})
+			if err := m.Withheld[len(m.Withheld)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTopEarnersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTopEarnersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTopEarnersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllTime", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllTime = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTopEarnersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTopEarnersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTopEarnersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Earners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Earners = append(m.Earners, EpochValidatorRewards{})
+			if err := m.Earners[len(m.Earners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeTotalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeTotalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeTotalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeTotalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeTotalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeTotalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTotals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeTotals.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
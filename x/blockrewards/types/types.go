@@ -1,7 +1,6 @@
 package types
 
 import (
-	"cosmossdk.io/math"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -17,16 +16,15 @@ func NewGenesisState() *GenesisState {
 // DefaultGenesisState returns the default genesis state for the blockrewards module.
 func DefaultGenesisState() GenesisState {
     return GenesisState{
-		Params: Params{
-			BlockRewardAmount:  sdk.NewCoin("stake", math.NewInt(100000)) ,
-		},
+		Params: DefaultParams(),
     }
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
-	// If you have interfaces to register, add them here. For example:
-	// registry.RegisterImplementations(
-	//     (*sdk.Msg)(nil), // Register any Msg types here if needed
-	// )
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgClaimBlockRewards{},
+		&MsgUpdateParams{},
+	)
 }
 
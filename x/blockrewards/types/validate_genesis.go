@@ -1,6 +1,10 @@
 package types
 
-import fmt "fmt"
+import (
+	fmt "fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // Validate checks the validity of the GenesisState.
 func (gs GenesisState) Validate() error {
@@ -8,5 +12,73 @@ func (gs GenesisState) Validate() error {
     if err := gs.Params.Validate(); err != nil {
         return fmt.Errorf("invalid params: %w", err)
     }
+
+    seen := make(map[string]bool, len(gs.ValidatorRewards))
+    for _, r := range gs.ValidatorRewards {
+        if _, err := sdk.ValAddressFromBech32(r.ValidatorAddress); err != nil {
+            return fmt.Errorf("invalid validator address %q in rewards ledger: %w", r.ValidatorAddress, err)
+        }
+        if seen[r.ValidatorAddress] {
+            return fmt.Errorf("duplicate rewards ledger entry for validator %s", r.ValidatorAddress)
+        }
+        seen[r.ValidatorAddress] = true
+        if err := r.TotalRewards.Validate(); err != nil {
+            return fmt.Errorf("invalid total rewards for validator %s: %w", r.ValidatorAddress, err)
+        }
+    }
+
+    seenEpoch := make(map[string]bool, len(gs.EpochRewards))
+    for _, r := range gs.EpochRewards {
+        if _, err := sdk.ValAddressFromBech32(r.ValidatorAddress); err != nil {
+            return fmt.Errorf("invalid validator address %q in epoch rewards: %w", r.ValidatorAddress, err)
+        }
+        key := fmt.Sprintf("%d/%s", r.Epoch, r.ValidatorAddress)
+        if seenEpoch[key] {
+            return fmt.Errorf("duplicate epoch %d rewards entry for validator %s", r.Epoch, r.ValidatorAddress)
+        }
+        seenEpoch[key] = true
+        if err := r.Rewards.Validate(); err != nil {
+            return fmt.Errorf("invalid epoch %d rewards for validator %s: %w", r.Epoch, r.ValidatorAddress, err)
+        }
+    }
+
+    seenAccrued := make(map[string]bool, len(gs.AccruedRewards))
+    for _, r := range gs.AccruedRewards {
+        if _, err := sdk.ValAddressFromBech32(r.ValidatorAddress); err != nil {
+            return fmt.Errorf("invalid validator address %q in accrued rewards: %w", r.ValidatorAddress, err)
+        }
+        if seenAccrued[r.ValidatorAddress] {
+            return fmt.Errorf("duplicate accrued rewards entry for validator %s", r.ValidatorAddress)
+        }
+        seenAccrued[r.ValidatorAddress] = true
+        if err := r.Rewards.Validate(); err != nil {
+            return fmt.Errorf("invalid accrued rewards for validator %s: %w", r.ValidatorAddress, err)
+        }
+    }
+
+    seenWithheld := make(map[string]bool, len(gs.WithheldRewards))
+    for _, w := range gs.WithheldRewards {
+        if _, err := sdk.ValAddressFromBech32(w.ValidatorAddress); err != nil {
+            return fmt.Errorf("invalid validator address %q in withheld rewards: %w", w.ValidatorAddress, err)
+        }
+        key := fmt.Sprintf("%d/%s", w.ReleaseHeight, w.ValidatorAddress)
+        if seenWithheld[key] {
+            return fmt.Errorf("duplicate withheld rewards entry for validator %s at release height %d", w.ValidatorAddress, w.ReleaseHeight)
+        }
+        seenWithheld[key] = true
+        if w.ReleaseHeight < w.EarnedHeight {
+            return fmt.Errorf("withheld rewards of validator %s are released at %d, before they were earned at %d", w.ValidatorAddress, w.ReleaseHeight, w.EarnedHeight)
+        }
+        if err := w.Rewards.Validate(); err != nil {
+            return fmt.Errorf("invalid withheld rewards for validator %s: %w", w.ValidatorAddress, err)
+        }
+    }
+
+    if err := gs.FeeTotals.Redirected.Validate(); err != nil {
+        return fmt.Errorf("invalid redirected fee total: %w", err)
+    }
+    if err := gs.FeeTotals.Burned.Validate(); err != nil {
+        return fmt.Errorf("invalid burned fee total: %w", err)
+    }
     return nil
-}
\ No newline at end of file
+}
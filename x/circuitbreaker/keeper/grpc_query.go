@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-provider/x/circuitbreaker/types"
+)
+
+// queryServer implements types.QueryServer.
+type queryServer struct {
+	Keeper
+}
+
+// Ensure queryServer implements the generated QueryServer.
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServer returns a types.QueryServer backed by the given keeper.
+func NewQueryServer(k Keeper) types.QueryServer {
+	return queryServer{k}
+}
+
+// Params returns the module parameters.
+func (q queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	return &types.QueryParamsResponse{Params: q.GetParams(sdk.UnwrapSDKContext(ctx))}, nil
+}
+
+// Paused returns the paused msg types and channels.
+func (q queryServer) Paused(ctx context.Context, _ *types.QueryPausedRequest) (*types.QueryPausedResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.QueryPausedResponse{
+		MsgTypes: q.GetPausedMsgTypes(sdkCtx),
+		Channels: q.GetPausedChannels(sdkCtx),
+	}, nil
+}
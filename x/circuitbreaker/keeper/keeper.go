@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-provider/x/circuitbreaker/types"
+)
+
+// Keeper holds the paused msg types and channels.
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+
+	// the address capable of executing a MsgUpdateParams message, typically x/gov
+	authority string
+}
+
+// NewKeeper creates a new circuitbreaker Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, authority string) Keeper {
+	return Keeper{
+		cdc:       cdc,
+		storeKey:  storeKey,
+		authority: authority,
+	}
+}
+
+// GetAuthority returns the module authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the module parameters, or the defaults if none are set.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	bz := ctx.KVStore(k.storeKey).Get(types.ParamsKey)
+	if bz == nil {
+		return types.DefaultParams()
+	}
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return fmt.Errorf("invalid params: %w", err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.ParamsKey, k.cdc.MustMarshal(&params))
+	return nil
+}
+
+// CanPause returns whether addr is the authority or a guardian.
+func (k Keeper) CanPause(ctx sdk.Context, addr string) bool {
+	return addr == k.authority || k.GetParams(ctx).IsGuardian(addr)
+}
+
+// IsMsgTypePaused returns whether the msg type URL is paused.
+func (k Keeper) IsMsgTypePaused(ctx sdk.Context, msgType string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.PausedMsgTypeKey(msgType))
+}
+
+func (k Keeper) SetMsgTypePaused(ctx sdk.Context, msgType string, paused bool) {
+	if paused {
+		ctx.KVStore(k.storeKey).Set(types.PausedMsgTypeKey(msgType), []byte{1})
+	} else {
+		ctx.KVStore(k.storeKey).Delete(types.PausedMsgTypeKey(msgType))
+	}
+}
+
+// GetPausedMsgTypes returns the paused msg type URLs.
+func (k Keeper) GetPausedMsgTypes(ctx sdk.Context) []string {
+	return k.iterate(ctx, types.PausedMsgTypePrefix)
+}
+
+// IsChannelPaused returns whether inbound packets on the channel are rejected.
+func (k Keeper) IsChannelPaused(ctx sdk.Context, channelID string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.PausedChannelKey(channelID))
+}
+
+func (k Keeper) SetChannelPaused(ctx sdk.Context, channelID string, paused bool) {
+	if paused {
+		ctx.KVStore(k.storeKey).Set(types.PausedChannelKey(channelID), []byte{1})
+	} else {
+		ctx.KVStore(k.storeKey).Delete(types.PausedChannelKey(channelID))
+	}
+}
+
+// GetPausedChannels returns the IDs of the paused channels.
+func (k Keeper) GetPausedChannels(ctx sdk.Context) []string {
+	return k.iterate(ctx, types.PausedChannelPrefix)
+}
+
+func (k Keeper) iterate(ctx sdk.Context, prefix []byte) []string {
+	iter := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iter.Close()
+
+	var out []string
+	for ; iter.Valid(); iter.Next() {
+		out = append(out, string(iter.Key()[len(prefix):]))
+	}
+	return out
+}
+
+// InitGenesis stores the params and the paused msg types and channels.
+func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
+	if err := k.SetParams(ctx, gs.Params); err != nil {
+		panic(err)
+	}
+	for _, msgType := range gs.PausedMsgTypes {
+		k.SetMsgTypePaused(ctx, msgType, true)
+	}
+	for _, channelID := range gs.PausedChannels {
+		k.SetChannelPaused(ctx, channelID, true)
+	}
+}
+
+// ExportGenesis returns the params and the paused msg types and channels.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		Params:         k.GetParams(ctx),
+		PausedMsgTypes: k.GetPausedMsgTypes(ctx),
+		PausedChannels: k.GetPausedChannels(ctx),
+	}
+}
@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/maany-xyz/maany-provider/app/helpers"
+	gaiaerrors "github.com/maany-xyz/maany-provider/types/errors"
+	"github.com/maany-xyz/maany-provider/x/circuitbreaker/keeper"
+	"github.com/maany-xyz/maany-provider/x/circuitbreaker/types"
+	mintburnmodule "github.com/maany-xyz/maany-provider/x/mintburn/module"
+	mintburntypes "github.com/maany-xyz/maany-provider/x/mintburn/types"
+)
+
+func TestPauseAndResume(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+	k := gaiaApp.CircuitBreakerKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	guardian := sdk.AccAddress("guardian_multisig___").String()
+	escrowType := sdk.MsgTypeURL(&mintburntypes.MsgEscrowInitial{})
+	pause := &types.MsgPause{Signer: guardian, MsgTypes: []string{escrowType}, Channels: []string{"channel-0"}}
+
+	// only the authority and the guardians may pause
+	_, err := msgServer.Pause(ctx, pause)
+	require.Error(t, err)
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: guardian, Params: types.Params{Guardians: []string{guardian}}})
+	require.Error(t, err)
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: types.Params{Guardians: []string{guardian}}})
+	require.NoError(t, err)
+
+	// unknown msg types are rejected
+	_, err = msgServer.Pause(ctx, &types.MsgPause{Signer: guardian, MsgTypes: []string{"/maany.mintburn.v1.MsgUnknown"}})
+	require.Error(t, err)
+
+	// msg types outside the mintburn escrow messages are left to the authority
+	sendType := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	_, err = msgServer.Pause(ctx, &types.MsgPause{Signer: guardian, MsgTypes: []string{sendType}})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.Pause(ctx, &types.MsgPause{Signer: k.GetAuthority(), MsgTypes: []string{sendType}})
+	require.NoError(t, err)
+	require.True(t, k.IsMsgTypePaused(ctx, sendType))
+
+	_, err = msgServer.Pause(ctx, pause)
+	require.NoError(t, err)
+	require.True(t, k.IsMsgTypePaused(ctx, escrowType))
+	require.True(t, k.IsChannelPaused(ctx, "channel-0"))
+	require.False(t, k.IsChannelPaused(ctx, "channel-1"))
+
+	gs := k.ExportGenesis(ctx)
+	require.ElementsMatch(t, []string{escrowType, sendType}, gs.PausedMsgTypes)
+	require.Equal(t, []string{"channel-0"}, gs.PausedChannels)
+	require.NoError(t, gs.Validate())
+
+	_, err = msgServer.Resume(ctx, &types.MsgResume{Signer: k.GetAuthority(), MsgTypes: []string{escrowType}})
+	require.NoError(t, err)
+	require.False(t, k.IsMsgTypePaused(ctx, escrowType))
+	require.True(t, k.IsChannelPaused(ctx, "channel-0"))
+}
+
+func TestCircuitBreakerMsgTypesCantBePaused(t *testing.T) {
+	for _, msgType := range []string{sdk.MsgTypeURL(&types.MsgResume{}), sdk.MsgTypeURL(&govv1.MsgSubmitProposal{})} {
+		msg := &types.MsgPause{
+			Signer:   sdk.AccAddress("guardian_multisig___").String(),
+			MsgTypes: []string{msgType},
+		}
+		require.Error(t, msg.ValidateBasic())
+	}
+}
+
+func TestPausedMintburn(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+	k := gaiaApp.CircuitBreakerKeeper
+
+	// the msg server rejects paused msgs that bypass the ante handler
+	escrow := &mintburntypes.MsgEscrowInitial{
+		Sender:          sdk.AccAddress("escrow_sender_______").String(),
+		ConsumerChainId: "consumer-1",
+		Amount:          sdk.NewInt64Coin("stake", 1000),
+	}
+	k.SetMsgTypePaused(ctx, sdk.MsgTypeURL(escrow), true)
+	_, err := gaiaApp.MintBurnKeeper.EscrowInitial(ctx, escrow)
+	require.ErrorIs(t, err, gaiaerrors.ErrPaused)
+
+	// packets received on a paused channel get an error ack, before reaching
+	// the wrapped app
+	k.SetChannelPaused(ctx, "channel-0", true)
+	middleware := mintburnmodule.NewIBCMiddleware(nil, gaiaApp.MintBurnKeeper)
+	ack := middleware.OnRecvPacket(ctx, channeltypes.Packet{
+		SourcePort:         "transfer",
+		SourceChannel:      "channel-7",
+		DestinationPort:    "transfer",
+		DestinationChannel: "channel-0",
+		Sequence:           1,
+	}, sdk.AccAddress("relayer_____________"))
+	require.False(t, ack.Success())
+}
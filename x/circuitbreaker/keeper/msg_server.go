@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/maany-xyz/maany-provider/x/circuitbreaker/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// Ensure msgServer implements the generated MsgServer.
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the circuitbreaker MsgServer.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return msgServer{k}
+}
+
+// UpdateParams replaces the module parameters.
+func (m msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if msg.Authority != m.authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.authority, msg.Authority)
+	}
+	if err := msg.Params.Validate(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// Pause pauses the given msg types and channels.
+func (m msgServer) Pause(goCtx context.Context, msg *types.MsgPause) (*types.MsgPauseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.setPaused(ctx, msg.Signer, msg.MsgTypes, msg.Channels, true); err != nil {
+		return nil, err
+	}
+	return &types.MsgPauseResponse{}, nil
+}
+
+// Resume resumes the given msg types and channels.
+func (m msgServer) Resume(goCtx context.Context, msg *types.MsgResume) (*types.MsgResumeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.setPaused(ctx, msg.Signer, msg.MsgTypes, msg.Channels, false); err != nil {
+		return nil, err
+	}
+	return &types.MsgResumeResponse{}, nil
+}
+
+func (m msgServer) setPaused(ctx sdk.Context, signer string, msgTypes, channels []string, paused bool) error {
+	if !m.CanPause(ctx, signer) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is neither the authority nor a guardian", signer)
+	}
+	// catch typos, a paused type that does not exist protects nothing
+	for _, msgType := range msgTypes {
+		if proto.MessageType(strings.TrimPrefix(msgType, "/")) == nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unknown msg type %s", msgType)
+		}
+		if signer != m.authority && !types.IsGuardianMsgType(msgType) {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "only the authority may pause or resume %s", msgType)
+		}
+	}
+
+	eventType := "circuitbreaker_resume"
+	if paused {
+		eventType = "circuitbreaker_pause"
+	}
+	for _, msgType := range msgTypes {
+		m.SetMsgTypePaused(ctx, msgType, paused)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(eventType,
+			sdk.NewAttribute("signer", signer),
+			sdk.NewAttribute("msg_type", msgType),
+		))
+	}
+	for _, channelID := range channels {
+		m.SetChannelPaused(ctx, channelID, paused)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(eventType,
+			sdk.NewAttribute("signer", signer),
+			sdk.NewAttribute("channel_id", channelID),
+		))
+	}
+	return nil
+}
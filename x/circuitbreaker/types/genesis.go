@@ -0,0 +1,27 @@
+package types
+
+// DefaultGenesisState returns the default genesis state of the circuitbreaker
+// module, nothing is paused.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate checks the validity of the GenesisState.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	for _, msgType := range gs.PausedMsgTypes {
+		if err := ValidateMsgType(msgType); err != nil {
+			return err
+		}
+	}
+	for _, channelID := range gs.PausedChannels {
+		if err := ValidateChannel(channelID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "circuitbreaker"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	ParamsKey = []byte("Params")
+
+	// PausedMsgTypePrefix is the prefix of the paused msg types: 0x01 | type URL
+	PausedMsgTypePrefix = []byte{0x01}
+
+	// PausedChannelPrefix is the prefix of the paused channels: 0x02 | channel ID
+	PausedChannelPrefix = []byte{0x02}
+)
+
+// PausedMsgTypeKey returns the store key of a paused msg type.
+func PausedMsgTypeKey(msgType string) []byte {
+	return append(append([]byte{}, PausedMsgTypePrefix...), msgType...)
+}
+
+// PausedChannelKey returns the store key of a paused channel.
+func PausedChannelKey(channelID string) []byte {
+	return append(append([]byte{}, PausedChannelPrefix...), channelID...)
+}
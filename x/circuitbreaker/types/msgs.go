@@ -0,0 +1,49 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateBasic for MsgUpdateParams
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "authority: %v", err)
+	}
+	if err := m.Params.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// ValidateBasic for MsgPause
+func (m *MsgPause) ValidateBasic() error {
+	return validateTargets(m.Signer, m.MsgTypes, m.Channels)
+}
+
+// ValidateBasic for MsgResume
+func (m *MsgResume) ValidateBasic() error {
+	return validateTargets(m.Signer, m.MsgTypes, m.Channels)
+}
+
+func validateTargets(signer string, msgTypes, channels []string) error {
+	if _, err := sdk.AccAddressFromBech32(signer); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "signer: %v", err)
+	}
+	if len(msgTypes) == 0 && len(channels) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no msg types or channels")
+	}
+	for _, msgType := range msgTypes {
+		if err := ValidateMsgType(msgType); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+	for _, channelID := range channels {
+		if err := ValidateChannel(channelID); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+	return nil
+}
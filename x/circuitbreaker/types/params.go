@@ -0,0 +1,81 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	mintburntypes "github.com/maany-xyz/maany-provider/x/mintburn/types"
+)
+
+// DefaultParams has no guardians, only x/gov can pause.
+func DefaultParams() Params {
+	return Params{}
+}
+
+// Validate performs validation on the circuitbreaker module parameters.
+func (p Params) Validate() error {
+	seen := make(map[string]bool, len(p.Guardians))
+	for _, guardian := range p.Guardians {
+		if _, err := sdk.AccAddressFromBech32(guardian); err != nil {
+			return fmt.Errorf("invalid guardian %s: %w", guardian, err)
+		}
+		if seen[guardian] {
+			return fmt.Errorf("duplicate guardian %s", guardian)
+		}
+		seen[guardian] = true
+	}
+	return nil
+}
+
+// IsGuardian returns whether addr may pause and resume.
+func (p Params) IsGuardian(addr string) bool {
+	for _, guardian := range p.Guardians {
+		if guardian == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateMsgType checks that msgType is a type URL that may be paused. The
+// circuitbreaker and gov messages can't be paused, or pausing MsgResume or the
+// proposal messages would leave no way to resume.
+func ValidateMsgType(msgType string) error {
+	if !strings.HasPrefix(msgType, "/") || len(msgType) == 1 {
+		return fmt.Errorf("invalid msg type URL %q", msgType)
+	}
+	for _, p := range unpausableMsgTypePrefixes {
+		if strings.HasPrefix(msgType, p) {
+			return fmt.Errorf("msg type %s can't be paused", msgType)
+		}
+	}
+	return nil
+}
+
+var unpausableMsgTypePrefixes = []string{"/maany.circuitbreaker.", "/cosmos.gov."}
+
+// GuardianMsgTypes are the msg types a guardian may pause and resume. Any
+// other msg type is left to the authority.
+var GuardianMsgTypes = []string{
+	sdk.MsgTypeURL(&mintburntypes.MsgEscrowInitial{}),
+	sdk.MsgTypeURL(&mintburntypes.MsgCancelEscrow{}),
+	sdk.MsgTypeURL(&mintburntypes.MsgMarkEscrowClaimed{}),
+}
+
+// IsGuardianMsgType returns whether a guardian may pause msgType.
+func IsGuardianMsgType(msgType string) bool {
+	for _, t := range GuardianMsgTypes {
+		if t == msgType {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateChannel checks that channelID is a valid channel identifier.
+func ValidateChannel(channelID string) error {
+	return host.ChannelIdentifierValidator(channelID)
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-provider/x/expedited/types"
+)
+
+// queryServer implements types.QueryServer.
+type queryServer struct {
+	Keeper
+}
+
+// Ensure queryServer implements the generated QueryServer.
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServer returns a types.QueryServer backed by the given keeper.
+func NewQueryServer(k Keeper) types.QueryServer {
+	return queryServer{k}
+}
+
+// Params returns the module parameters.
+func (q queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	return &types.QueryParamsResponse{Params: q.GetParams(sdk.UnwrapSDKContext(ctx))}, nil
+}
+
+// Whitelisted returns whether a msg type may be part of an expedited proposal.
+func (q queryServer) Whitelisted(ctx context.Context, req *types.QueryWhitelistedRequest) (*types.QueryWhitelistedResponse, error) {
+	if req == nil || req.MsgType == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty msg type")
+	}
+	return &types.QueryWhitelistedResponse{
+		Whitelisted: q.IsWhitelisted(sdk.UnwrapSDKContext(ctx), req.MsgType),
+	}, nil
+}
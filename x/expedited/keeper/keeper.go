@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-provider/x/expedited/types"
+)
+
+// Keeper holds the expedited proposal whitelist.
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+
+	// the address capable of executing a MsgUpdateParams message, typically x/gov
+	authority string
+}
+
+// NewKeeper creates a new expedited Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, authority string) Keeper {
+	return Keeper{
+		cdc:       cdc,
+		storeKey:  storeKey,
+		authority: authority,
+	}
+}
+
+// GetAuthority returns the module authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the module parameters, or the defaults if none are set.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	bz := ctx.KVStore(k.storeKey).Get(types.ParamsKey)
+	if bz == nil {
+		return types.DefaultParams()
+	}
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return fmt.Errorf("invalid params: %w", err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.ParamsKey, k.cdc.MustMarshal(&params))
+	return nil
+}
+
+// IsWhitelisted returns whether msgType may be part of an expedited proposal.
+func (k Keeper) IsWhitelisted(ctx sdk.Context, msgType string) bool {
+	return k.GetParams(ctx).IsWhitelisted(msgType)
+}
+
+// InitGenesis stores the params of the genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
+	if err := k.SetParams(ctx, gs.Params); err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis returns the params as a genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{Params: k.GetParams(ctx)}
+}
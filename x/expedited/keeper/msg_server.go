@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/maany-xyz/maany-provider/x/expedited/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// Ensure msgServer implements the generated MsgServer.
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the expedited MsgServer.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return msgServer{k}
+}
+
+// UpdateParams replaces the module parameters.
+func (m msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if msg.Authority != m.authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.authority, msg.Authority)
+	}
+	if err := msg.Params.Validate(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// catch typos, a whitelisted type that does not exist can never match
+	for _, msgType := range msg.Params.WhitelistedMsgTypes {
+		if proto.MessageType(strings.TrimPrefix(msgType, "/")) == nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unknown msg type %s", msgType)
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,13 @@
+package types
+
+// DefaultGenesisState returns the default genesis state of the expedited module.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate checks the validity of the GenesisState.
+func (gs GenesisState) Validate() error {
+	return gs.Params.Validate()
+}
@@ -0,0 +1,11 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "expedited"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var ParamsKey = []byte("Params")
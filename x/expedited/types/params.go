@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultParams whitelists the software upgrade messages, which were the only
+// proposals that could be expedited before the whitelist was moved on chain.
+func DefaultParams() Params {
+	return Params{
+		WhitelistedMsgTypes: []string{
+			"/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade",
+			"/cosmos.upgrade.v1beta1.MsgCancelUpgrade",
+		},
+	}
+}
+
+// Validate performs validation on the expedited module parameters.
+func (p Params) Validate() error {
+	seen := make(map[string]bool, len(p.WhitelistedMsgTypes))
+	for _, msgType := range p.WhitelistedMsgTypes {
+		if !strings.HasPrefix(msgType, "/") || len(msgType) == 1 {
+			return fmt.Errorf("invalid msg type URL %q", msgType)
+		}
+		if seen[msgType] {
+			return fmt.Errorf("duplicate msg type URL %s", msgType)
+		}
+		seen[msgType] = true
+	}
+	return nil
+}
+
+// IsWhitelisted returns whether msgType may be part of an expedited proposal.
+func (p Params) IsWhitelisted(msgType string) bool {
+	for _, t := range p.WhitelistedMsgTypes {
+		if t == msgType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-provider/x/feeprices/types"
+)
+
+// queryServer implements types.QueryServer.
+type queryServer struct {
+	Keeper
+}
+
+// Ensure queryServer implements the generated QueryServer.
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServer returns a types.QueryServer backed by the given keeper.
+func NewQueryServer(k Keeper) types.QueryServer {
+	return queryServer{k}
+}
+
+// Params returns the module parameters.
+func (q queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	return &types.QueryParamsResponse{Params: q.GetParams(sdk.UnwrapSDKContext(ctx))}, nil
+}
+
+// Prices returns the governance-set price table.
+func (q queryServer) Prices(ctx context.Context, _ *types.QueryPricesRequest) (*types.QueryPricesResponse, error) {
+	return &types.QueryPricesResponse{Prices: q.GetAllPrices(sdk.UnwrapSDKContext(ctx))}, nil
+}
+
+// Price returns the price fees in denom are currently converted with.
+func (q queryServer) Price(ctx context.Context, req *types.QueryPriceRequest) (*types.QueryPriceResponse, error) {
+	if req == nil || req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+	price, err := q.CurrentPrice(sdk.UnwrapSDKContext(ctx), req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &types.QueryPriceResponse{Price: price}, nil
+}
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-provider/x/feeprices/types"
+)
+
+// Keeper holds the price table of the denoms fees may be paid in. It is the
+// denom resolver of the fee market.
+type Keeper struct {
+	cdc        codec.BinaryCodec
+	storeKey   storetypes.StoreKey
+	wasmKeeper types.WasmKeeper
+
+	// the address capable of executing a MsgUpdateParams message, typically x/gov
+	authority string
+}
+
+// NewKeeper creates a new feeprices Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, wasmKeeper types.WasmKeeper, authority string) Keeper {
+	return Keeper{
+		cdc:        cdc,
+		storeKey:   storeKey,
+		wasmKeeper: wasmKeeper,
+		authority:  authority,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetAuthority returns the module authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the module parameters, or the defaults if none are set.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	bz := ctx.KVStore(k.storeKey).Get(types.ParamsKey)
+	if bz == nil {
+		return types.DefaultParams()
+	}
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return fmt.Errorf("invalid params: %w", err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.ParamsKey, k.cdc.MustMarshal(&params))
+	return nil
+}
+
+// GetPrice returns the governance-set price of denom.
+func (k Keeper) GetPrice(ctx sdk.Context, denom string) (types.DenomPrice, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.PriceKey(denom))
+	if bz == nil {
+		return types.DenomPrice{}, false
+	}
+	var price types.DenomPrice
+	k.cdc.MustUnmarshal(bz, &price)
+	return price, true
+}
+
+func (k Keeper) SetPrice(ctx sdk.Context, price types.DenomPrice) {
+	ctx.KVStore(k.storeKey).Set(types.PriceKey(price.Denom), k.cdc.MustMarshal(&price))
+}
+
+func (k Keeper) DeletePrice(ctx sdk.Context, denom string) {
+	ctx.KVStore(k.storeKey).Delete(types.PriceKey(denom))
+}
+
+// GetAllPrices returns the price table ordered by denom.
+func (k Keeper) GetAllPrices(ctx sdk.Context) []types.DenomPrice {
+	iter := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.PricePrefix)
+	defer iter.Close()
+
+	var prices []types.DenomPrice
+	for ; iter.Valid(); iter.Next() {
+		var price types.DenomPrice
+		k.cdc.MustUnmarshal(iter.Value(), &price)
+		prices = append(prices, price)
+	}
+	return prices
+}
+
+// InitGenesis stores the params and the price table.
+func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
+	if err := k.SetParams(ctx, gs.Params); err != nil {
+		panic(err)
+	}
+	for _, price := range gs.Prices {
+		k.SetPrice(ctx, price)
+	}
+}
+
+// ExportGenesis returns the params and the price table.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		Params: k.GetParams(ctx),
+		Prices: k.GetAllPrices(ctx),
+	}
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/maany-xyz/maany-provider/x/feeprices/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// Ensure msgServer implements the generated MsgServer.
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the feeprices MsgServer.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return msgServer{k}
+}
+
+// UpdateParams replaces the module parameters.
+func (m msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if msg.Authority != m.authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.authority, msg.Authority)
+	}
+	if err := msg.Params.Validate(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// SetPrices sets the rates at the current block time, a zero rate removes the
+// denom from the price table.
+func (m msgServer) SetPrices(goCtx context.Context, msg *types.MsgSetPrices) (*types.MsgSetPricesResponse, error) {
+	if msg.Authority != m.authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, r := range msg.Rates {
+		if err := types.ValidateRate(r.Denom, r.Rate, true); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if r.Rate.IsZero() {
+			m.DeletePrice(ctx, r.Denom)
+			continue
+		}
+		m.SetPrice(ctx, types.DenomPrice{
+			Denom:     r.Denom,
+			Rate:      r.Rate,
+			UpdatedAt: ctx.BlockTime(),
+		})
+	}
+	return &types.MsgSetPricesResponse{}, nil
+}
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-provider/x/feeprices/types"
+)
+
+// oracleQuery is the smart query sent to the oracle contract:
+// {"price":{"denom":"ibc/..."}}
+type oracleQuery struct {
+	Price struct {
+		Denom string `json:"denom"`
+	} `json:"price"`
+}
+
+// oracleResponse is the answer of the oracle contract:
+// {"rate":"0.25","updated_at":1700000000}, updated_at in unix seconds.
+type oracleResponse struct {
+	Rate      sdkmath.LegacyDec `json:"rate"`
+	UpdatedAt int64             `json:"updated_at"`
+}
+
+// CurrentPrice returns the price fees in denom are converted with. Only denoms
+// in the price table are accepted. A fresh price of the oracle contract, if
+// one is set, is preferred over the governance-set price, which must be fresh
+// otherwise.
+func (k Keeper) CurrentPrice(ctx sdk.Context, denom string) (types.DenomPrice, error) {
+	price, found := k.GetPrice(ctx, denom)
+	if !found {
+		return types.DenomPrice{}, fmt.Errorf("fees can't be paid in %s", denom)
+	}
+
+	params := k.GetParams(ctx)
+	if params.OracleContract != "" {
+		oraclePrice, err := k.queryOracle(ctx, params.OracleContract, denom)
+		if err == nil && params.IsFresh(oraclePrice.UpdatedAt, ctx.BlockTime()) {
+			return oraclePrice, nil
+		}
+		if err != nil {
+			k.Logger(ctx).Debug("price oracle query failed", "denom", denom, "err", err)
+		}
+	}
+
+	if !params.IsFresh(price.UpdatedAt, ctx.BlockTime()) {
+		return types.DenomPrice{}, fmt.Errorf("price of %s set at %s is stale", denom, price.UpdatedAt)
+	}
+	return price, nil
+}
+
+func (k Keeper) queryOracle(ctx sdk.Context, contract, denom string) (types.DenomPrice, error) {
+	contractAddr, err := sdk.AccAddressFromBech32(contract)
+	if err != nil {
+		return types.DenomPrice{}, err
+	}
+	var query oracleQuery
+	query.Price.Denom = denom
+	req, err := json.Marshal(query)
+	if err != nil {
+		return types.DenomPrice{}, err
+	}
+	bz, err := k.wasmKeeper.QuerySmart(ctx, contractAddr, req)
+	if err != nil {
+		return types.DenomPrice{}, err
+	}
+	var resp oracleResponse
+	if err := json.Unmarshal(bz, &resp); err != nil {
+		return types.DenomPrice{}, err
+	}
+	price := types.DenomPrice{
+		Denom:     denom,
+		Rate:      resp.Rate,
+		UpdatedAt: time.Unix(resp.UpdatedAt, 0).UTC(),
+	}
+	if err := price.Validate(); err != nil {
+		return types.DenomPrice{}, err
+	}
+	return price, nil
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	feemarkettypes "github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// Ensure Keeper can resolve the fee denoms of the fee market.
+var _ feemarkettypes.DenomResolver = Keeper{}
+
+// ConvertToDenom converts coin into denom with the current prices. The fee
+// market only converts from and to its fee denom, which has no price: a coin
+// in the fee denom is multiplied by the rate of denom, and a coin in a priced
+// denom converted to the fee denom is divided by its rate. Converting between
+// two denoms that have no price fails.
+func (k Keeper) ConvertToDenom(ctx sdk.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error) {
+	if coin.Denom == denom {
+		return coin, nil
+	}
+
+	amount, priced := coin.Amount, false
+	if _, found := k.GetPrice(ctx, coin.Denom); found {
+		from, err := k.CurrentPrice(ctx, coin.Denom)
+		if err != nil {
+			return sdk.DecCoin{}, err
+		}
+		amount, priced = amount.Quo(from.Rate), true
+	}
+	if _, found := k.GetPrice(ctx, denom); found {
+		to, err := k.CurrentPrice(ctx, denom)
+		if err != nil {
+			return sdk.DecCoin{}, err
+		}
+		amount, priced = amount.Mul(to.Rate), true
+	}
+	if !priced {
+		return sdk.DecCoin{}, fmt.Errorf("error resolving denom %s to %s", coin.Denom, denom)
+	}
+
+	return sdk.NewDecCoinFromDec(denom, amount), nil
+}
+
+// ExtraDenoms returns the denoms of the price table whose price is usable.
+func (k Keeper) ExtraDenoms(ctx sdk.Context) ([]string, error) {
+	denoms := []string{}
+	for _, price := range k.GetAllPrices(ctx) {
+		if _, err := k.CurrentPrice(ctx, price.Denom); err == nil {
+			denoms = append(denoms, price.Denom)
+		}
+	}
+	return denoms, nil
+}
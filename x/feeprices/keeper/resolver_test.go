@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-provider/app/helpers"
+	"github.com/maany-xyz/maany-provider/x/feeprices/keeper"
+	"github.com/maany-xyz/maany-provider/x/feeprices/types"
+)
+
+const ibcDenom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+// oracleMock answers the price queries of the oracle contract.
+type oracleMock struct {
+	resp []byte
+	err  error
+}
+
+func (o *oracleMock) QuerySmart(_ context.Context, _ sdk.AccAddress, _ []byte) ([]byte, error) {
+	return o.resp, o.err
+}
+
+func TestConvertToDenom(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	now := time.Unix(1_700_000_000, 0).UTC()
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{}).WithBlockTime(now)
+	k := gaiaApp.FeePricesKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	oneStake := sdk.NewDecCoin("stake", sdkmath.NewInt(1))
+
+	// only the fee market fee denom resolves to itself
+	_, err := k.ConvertToDenom(ctx, oneStake, ibcDenom)
+	require.Error(t, err)
+	coin, err := k.ConvertToDenom(ctx, oneStake, "stake")
+	require.NoError(t, err)
+	require.Equal(t, oneStake, coin)
+
+	_, err = msgServer.SetPrices(ctx, &types.MsgSetPrices{
+		Authority: k.GetAuthority(),
+		Rates:     []types.DenomRate{{Denom: ibcDenom, Rate: sdkmath.LegacyMustNewDecFromStr("2.5")}},
+	})
+	require.NoError(t, err)
+
+	coin, err = k.ConvertToDenom(ctx, oneStake, ibcDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoinFromDec(ibcDenom, sdkmath.LegacyMustNewDecFromStr("2.5")), coin)
+	coin, err = k.ConvertToDenom(ctx, sdk.NewDecCoin(ibcDenom, sdkmath.NewInt(5)), "stake")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoin("stake", sdkmath.NewInt(2)), coin)
+
+	denoms, err := k.ExtraDenoms(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{ibcDenom}, denoms)
+
+	// stale prices are rejected
+	ctx = ctx.WithBlockTime(now.Add(types.DefaultParams().MaxPriceAge + time.Second))
+	_, err = k.ConvertToDenom(ctx, oneStake, ibcDenom)
+	require.Error(t, err)
+	denoms, err = k.ExtraDenoms(ctx)
+	require.NoError(t, err)
+	require.Empty(t, denoms)
+
+	// a zero rate removes the denom
+	_, err = msgServer.SetPrices(ctx, &types.MsgSetPrices{
+		Authority: k.GetAuthority(),
+		Rates:     []types.DenomRate{{Denom: ibcDenom, Rate: sdkmath.LegacyZeroDec()}},
+	})
+	require.NoError(t, err)
+	require.Empty(t, k.GetAllPrices(ctx))
+}
+
+func TestOraclePrices(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	now := time.Unix(1_700_000_000, 0).UTC()
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{}).WithBlockTime(now)
+	oracle := &oracleMock{}
+	k := keeper.NewKeeper(gaiaApp.AppCodec(), gaiaApp.AppKeepers.GetKey(types.StoreKey), oracle, gaiaApp.FeePricesKeeper.GetAuthority())
+
+	params := types.DefaultParams()
+	params.OracleContract = sdk.AccAddress("oracle_contract_____").String()
+	require.NoError(t, k.SetParams(ctx, params))
+	k.SetPrice(ctx, types.DenomPrice{Denom: ibcDenom, Rate: sdkmath.LegacyNewDec(2), UpdatedAt: now})
+
+	// a fresh oracle price is preferred
+	oracle.resp = []byte(`{"rate":"3.000000000000000000","updated_at":1700000000}`)
+	price, err := k.CurrentPrice(ctx, ibcDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyNewDec(3), price.Rate)
+
+	// the governance-set price is used when the oracle price is stale or the
+	// query fails
+	oracle.resp = []byte(`{"rate":"3.000000000000000000","updated_at":1600000000}`)
+	price, err = k.CurrentPrice(ctx, ibcDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyNewDec(2), price.Rate)
+
+	oracle.err = errors.New("contract not found")
+	price, err = k.CurrentPrice(ctx, ibcDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyNewDec(2), price.Rate)
+
+	// the oracle only prices denoms of the price table
+	oracle.err = nil
+	_, err = k.CurrentPrice(ctx, "uatom")
+	require.Error(t, err)
+}
@@ -0,0 +1,12 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// WasmKeeper queries the optional price oracle contract.
+type WasmKeeper interface {
+	QuerySmart(ctx context.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error)
+}
@@ -0,0 +1,29 @@
+package types
+
+import "fmt"
+
+// DefaultGenesisState returns the default genesis state of the feeprices
+// module, fees can only be paid in the fee market fee denom.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate checks the validity of the GenesisState.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	seen := make(map[string]bool, len(gs.Prices))
+	for _, price := range gs.Prices {
+		if err := price.Validate(); err != nil {
+			return err
+		}
+		if seen[price.Denom] {
+			return fmt.Errorf("duplicate price for %s", price.Denom)
+		}
+		seen[price.Denom] = true
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "feeprices"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	ParamsKey = []byte("Params")
+
+	// PricePrefix is the prefix of the price table: 0x01 | denom
+	PricePrefix = []byte{0x01}
+)
+
+// PriceKey returns the store key of the price of denom.
+func PriceKey(denom string) []byte {
+	return append(append([]byte{}, PricePrefix...), denom...)
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateBasic for MsgUpdateParams
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "authority: %v", err)
+	}
+	if err := m.Params.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// ValidateBasic for MsgSetPrices
+func (m *MsgSetPrices) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "authority: %v", err)
+	}
+	if len(m.Rates) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no rates")
+	}
+	seen := make(map[string]bool, len(m.Rates))
+	for _, r := range m.Rates {
+		if err := ValidateRate(r.Denom, r.Rate, true); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if seen[r.Denom] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate rate for %s", r.Denom)
+		}
+		seen[r.Denom] = true
+	}
+	return nil
+}
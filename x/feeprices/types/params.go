@@ -0,0 +1,33 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultParams keeps prices usable for a day and has no oracle contract.
+func DefaultParams() Params {
+	return Params{
+		MaxPriceAge: 24 * time.Hour,
+	}
+}
+
+// Validate performs validation on the feeprices module parameters.
+func (p Params) Validate() error {
+	if p.MaxPriceAge < 0 {
+		return fmt.Errorf("negative max price age %s", p.MaxPriceAge)
+	}
+	if p.OracleContract != "" {
+		if _, err := sdk.AccAddressFromBech32(p.OracleContract); err != nil {
+			return fmt.Errorf("invalid oracle contract: %w", err)
+		}
+	}
+	return nil
+}
+
+// IsFresh returns whether a price set at updatedAt may still be used at now.
+func (p Params) IsFresh(updatedAt, now time.Time) bool {
+	return p.MaxPriceAge == 0 || !now.After(updatedAt.Add(p.MaxPriceAge))
+}
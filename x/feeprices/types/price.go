@@ -0,0 +1,26 @@
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateRate checks denom and a rate that must be positive, or zero if
+// allowZero is set.
+func ValidateRate(denom string, rate sdkmath.LegacyDec, allowZero bool) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return err
+	}
+	if rate.IsNil() || rate.IsNegative() || (rate.IsZero() && !allowZero) {
+		return fmt.Errorf("invalid rate %s for %s", rate, denom)
+	}
+	return nil
+}
+
+// Validate checks the denom and the rate of the price.
+func (p DenomPrice) Validate() error {
+	return ValidateRate(p.Denom, p.Rate, false)
+}
@@ -0,0 +1,49 @@
+package index
+
+import (
+	"github.com/cometbft/cometbft/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"github.com/maany-xyz/maany-provider/x/metaprotocols/types"
+)
+
+// IndexDecorator is a post decorator that records the ExtensionData attached
+// to successful txs in the node-local index. Indexing failures are logged and
+// never affect the tx, since the index is not part of consensus state.
+type IndexDecorator struct {
+	indexer *Indexer
+}
+
+// NewIndexDecorator returns an IndexDecorator writing to indexer.
+func NewIndexDecorator(indexer *Indexer) IndexDecorator {
+	return IndexDecorator{indexer: indexer}
+}
+
+func (d IndexDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	// only txs included in a block are indexed
+	if d.indexer == nil || simulate || !success || ctx.ExecMode() != sdk.ExecModeFinalize {
+		return next(ctx, tx, simulate, success)
+	}
+
+	// the rest of the post chain may still fail the tx, index only once it passed
+	newCtx, err := next(ctx, tx, simulate, success)
+	if err != nil {
+		return newCtx, err
+	}
+
+	exts := types.ExtensionDataFromTx(tx)
+	if len(exts) > 0 {
+		signer := ""
+		if sigTx, ok := tx.(authsigning.SigVerifiableTx); ok {
+			if signers, err := sigTx.GetSigners(); err == nil && len(signers) > 0 {
+				signer = sdk.AccAddress(signers[0]).String()
+			}
+		}
+		if err := d.indexer.Index(ctx.BlockHeight(), tmhash.Sum(ctx.TxBytes()), signer, exts); err != nil {
+			ctx.Logger().Error("failed to index metaprotocols extension data", "height", ctx.BlockHeight(), "error", err)
+		}
+	}
+	return newCtx, nil
+}
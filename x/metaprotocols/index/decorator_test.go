@@ -0,0 +1,52 @@
+package index_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/log"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-provider/x/metaprotocols/index"
+	"github.com/maany-xyz/maany-provider/x/metaprotocols/types"
+)
+
+type extensionTx struct {
+	opts []*codectypes.Any
+}
+
+func (extensionTx) GetMsgs() []sdk.Msg                                   { return nil }
+func (extensionTx) GetMsgsV2() ([]protov2.Message, error)                { return nil, nil }
+func (tx extensionTx) GetNonCriticalExtensionOptions() []*codectypes.Any { return tx.opts }
+
+func TestIndexDecoratorSkipsFailedPostChain(t *testing.T) {
+	idx := index.NewIndexer(dbm.NewMemDB(), 0)
+	decorator := index.NewIndexDecorator(idx)
+
+	ext, err := codectypes.NewAnyWithValue(&types.ExtensionData{ProtocolId: "dex", ProtocolVersion: "1", Data: []byte("order")})
+	require.NoError(t, err)
+	tx := extensionTx{opts: []*codectypes.Any{ext}}
+	ctx := sdk.Context{}.WithLogger(log.NewNopLogger()).WithExecMode(sdk.ExecModeFinalize).WithBlockHeight(1).WithTxBytes([]byte("tx"))
+
+	// a tx failed by the rest of the post chain is not indexed
+	failed := func(ctx sdk.Context, _ sdk.Tx, _, _ bool) (sdk.Context, error) { return ctx, errors.New("post failed") }
+	_, err = decorator.PostHandle(ctx, tx, false, true, failed)
+	require.Error(t, err)
+	txs, _, err := idx.TxsByProtocol("dex", 0, 0, nil, 10)
+	require.NoError(t, err)
+	require.Empty(t, txs)
+
+	passed := func(ctx sdk.Context, _ sdk.Tx, _, _ bool) (sdk.Context, error) { return ctx, nil }
+	_, err = decorator.PostHandle(ctx, tx, false, true, passed)
+	require.NoError(t, err)
+	txs, _, err = idx.TxsByProtocol("dex", 0, 0, nil, 10)
+	require.NoError(t, err)
+	require.Len(t, txs, 1)
+}
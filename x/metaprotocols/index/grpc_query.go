@@ -0,0 +1,63 @@
+package index
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/maany-xyz/maany-provider/x/metaprotocols/types"
+)
+
+// defaultLimit is used when a request sets no pagination limit.
+const defaultLimit = 100
+
+// Querier serves the index queries of the metaprotocols Query service.
+type Querier struct {
+	indexer *Indexer
+}
+
+// NewQuerier returns a Querier reading from indexer. A nil indexer answers
+// every query with codes.Unavailable.
+func NewQuerier(indexer *Indexer) Querier {
+	return Querier{indexer: indexer}
+}
+
+// TxsByProtocol returns the indexed txs of a protocol in a height range.
+func (q Querier) TxsByProtocol(_ context.Context, req *types.QueryTxsByProtocolRequest) (*types.QueryTxsByProtocolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if q.indexer == nil {
+		return nil, status.Error(codes.Unavailable, "metaprotocols indexing is not enabled on this node")
+	}
+	if req.ProtocolId == "" || len(req.ProtocolId) > 255 {
+		return nil, status.Error(codes.InvalidArgument, "invalid protocol id")
+	}
+	if req.MinHeight < 0 || req.MaxHeight < 0 || (req.MaxHeight > 0 && req.MaxHeight < req.MinHeight) {
+		return nil, status.Error(codes.InvalidArgument, "invalid height range")
+	}
+
+	var startKey []byte
+	limit := defaultLimit
+	if req.Pagination != nil {
+		if req.Pagination.Offset > 0 || req.Pagination.Reverse {
+			return nil, status.Error(codes.InvalidArgument, "only key based forward pagination is supported")
+		}
+		startKey = req.Pagination.Key
+		if req.Pagination.Limit > 0 {
+			limit = int(req.Pagination.Limit)
+		}
+	}
+
+	txs, nextKey, err := q.indexer.TxsByProtocol(req.ProtocolId, req.MinHeight, req.MaxHeight, startKey, limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryTxsByProtocolResponse{
+		Txs:        txs,
+		Pagination: &query.PageResponse{NextKey: nextKey},
+	}, nil
+}
@@ -0,0 +1,183 @@
+package index
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+
+	storetypes "cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+
+	"github.com/maany-xyz/maany-provider/x/metaprotocols/types"
+)
+
+// DBName is the name of the index database in the node's data directory.
+const DBName = "metaprotocols_index"
+
+var (
+	// txPrefix + len(protocol id) + protocol id + height + tx hash + position -> IndexedTx
+	txPrefix = []byte{0x01}
+	// heightPrefix + height + tx key -> nil, used for pruning
+	heightPrefix = []byte{0x02}
+)
+
+// Indexer records the ExtensionData attached to txs in a node-local database.
+// Nothing it stores is part of consensus state: entries are written while
+// blocks are finalized and pruned once they fall out of the retained window.
+type Indexer struct {
+	db           dbm.DB
+	retainBlocks uint64
+
+	mu         sync.Mutex
+	prunedUpTo int64
+}
+
+// NewIndexer returns an Indexer backed by db that keeps the last retainBlocks
+// blocks. A retainBlocks of 0 keeps everything.
+func NewIndexer(db dbm.DB, retainBlocks uint64) *Indexer {
+	return &Indexer{db: db, retainBlocks: retainBlocks}
+}
+
+// Close closes the underlying database.
+func (idx *Indexer) Close() error {
+	return idx.db.Close()
+}
+
+// Index records every ExtensionData of a tx included at height and prunes the
+// entries that fell out of the retained window. Re-indexing the same tx
+// overwrites its entries.
+func (idx *Indexer) Index(height int64, txHash []byte, signer string, exts []*types.ExtensionData) error {
+	batch := idx.db.NewBatch()
+	defer batch.Close()
+
+	hash := strings.ToUpper(hex.EncodeToString(txHash))
+	for i, ext := range exts {
+		if len(ext.ProtocolId) == 0 || len(ext.ProtocolId) > 255 {
+			continue
+		}
+		entry := types.IndexedTx{
+			ProtocolId:      ext.ProtocolId,
+			ProtocolVersion: ext.ProtocolVersion,
+			TxHash:          hash,
+			Height:          height,
+			Signer:          signer,
+		}
+		bz, err := entry.Marshal()
+		if err != nil {
+			return err
+		}
+		key := txKey(ext.ProtocolId, height, txHash, uint32(i))
+		if err := batch.Set(key, bz); err != nil {
+			return err
+		}
+		if err := batch.Set(append(heightKey(height), key...), []byte{}); err != nil {
+			return err
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return fmt.Errorf("failed to write metaprotocols index: %w", err)
+	}
+
+	return idx.Prune(height)
+}
+
+// Prune deletes the entries that are older than the retained window at height.
+func (idx *Indexer) Prune(height int64) error {
+	if idx.retainBlocks == 0 || height <= int64(idx.retainBlocks) {
+		return nil
+	}
+	cutoff := height - int64(idx.retainBlocks)
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	if cutoff <= idx.prunedUpTo {
+		return nil
+	}
+
+	it, err := idx.db.Iterator(heightPrefix, heightKey(cutoff+1))
+	if err != nil {
+		return err
+	}
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	if err := it.Close(); err != nil {
+		return err
+	}
+
+	batch := idx.db.NewBatch()
+	defer batch.Close()
+	for _, key := range keys {
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+		if err := batch.Delete(key[len(heightPrefix)+8:]); err != nil {
+			return err
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return fmt.Errorf("failed to prune metaprotocols index: %w", err)
+	}
+	idx.prunedUpTo = cutoff
+	return nil
+}
+
+// TxsByProtocol returns up to limit entries of a protocol between minHeight
+// and maxHeight, both inclusive, starting at startKey if it is set. It also
+// returns the key of the next entry, or nil if there is none.
+func (idx *Indexer) TxsByProtocol(protocolID string, minHeight, maxHeight int64, startKey []byte, limit int) ([]types.IndexedTx, []byte, error) {
+	prefix := protocolPrefix(protocolID)
+	start := append(append([]byte{}, prefix...), heightBytes(minHeight)...)
+	if len(startKey) > 0 {
+		start = append(append([]byte{}, prefix...), startKey...)
+	}
+	end := storetypes.PrefixEndBytes(prefix)
+	if maxHeight > 0 {
+		end = append(append([]byte{}, prefix...), heightBytes(maxHeight+1)...)
+	}
+
+	it, err := idx.db.Iterator(start, end)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer it.Close()
+
+	txs := make([]types.IndexedTx, 0)
+	for ; it.Valid(); it.Next() {
+		if len(txs) == limit {
+			return txs, it.Key()[len(prefix):], nil
+		}
+		var entry types.IndexedTx
+		if err := entry.Unmarshal(it.Value()); err != nil {
+			return nil, nil, err
+		}
+		txs = append(txs, entry)
+	}
+	return txs, nil, it.Error()
+}
+
+func protocolPrefix(protocolID string) []byte {
+	key := append([]byte{}, txPrefix...)
+	key = append(key, byte(len(protocolID)))
+	return append(key, protocolID...)
+}
+
+func txKey(protocolID string, height int64, txHash []byte, position uint32) []byte {
+	key := append(protocolPrefix(protocolID), heightBytes(height)...)
+	key = append(key, txHash...)
+	return binary.BigEndian.AppendUint32(key, position)
+}
+
+func heightKey(height int64) []byte {
+	return append(append([]byte{}, heightPrefix...), heightBytes(height)...)
+}
+
+func heightBytes(height int64) []byte {
+	if height < 0 {
+		height = 0
+	}
+	return binary.BigEndian.AppendUint64(nil, uint64(height))
+}
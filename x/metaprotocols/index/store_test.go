@@ -0,0 +1,60 @@
+package index_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"github.com/maany-xyz/maany-provider/x/metaprotocols/index"
+	"github.com/maany-xyz/maany-provider/x/metaprotocols/types"
+)
+
+func TestIndexQueryAndPrune(t *testing.T) {
+	idx := index.NewIndexer(dbm.NewMemDB(), 3)
+
+	dex := &types.ExtensionData{ProtocolId: "dex", ProtocolVersion: "1", Data: []byte("order")}
+	other := &types.ExtensionData{ProtocolId: "other", ProtocolVersion: "2", Data: []byte("x")}
+	for h := int64(1); h <= 3; h++ {
+		require.NoError(t, idx.Index(h, bytes.Repeat([]byte{byte(h)}, 32), "signer", []*types.ExtensionData{dex, other}))
+	}
+
+	txs, next, err := idx.TxsByProtocol("dex", 0, 0, nil, 10)
+	require.NoError(t, err)
+	require.Nil(t, next)
+	require.Len(t, txs, 3)
+	require.Equal(t, int64(1), txs[0].Height)
+	require.Equal(t, "1", txs[0].ProtocolVersion)
+	require.Equal(t, "signer", txs[0].Signer)
+
+	// height range is inclusive
+	txs, _, err = idx.TxsByProtocol("dex", 2, 2, nil, 10)
+	require.NoError(t, err)
+	require.Len(t, txs, 1)
+	require.Equal(t, int64(2), txs[0].Height)
+
+	// pagination resumes at the returned key
+	txs, next, err = idx.TxsByProtocol("dex", 0, 0, nil, 2)
+	require.NoError(t, err)
+	require.Len(t, txs, 2)
+	require.NotNil(t, next)
+	txs, next, err = idx.TxsByProtocol("dex", 0, 0, next, 2)
+	require.NoError(t, err)
+	require.Nil(t, next)
+	require.Len(t, txs, 1)
+	require.Equal(t, int64(3), txs[0].Height)
+
+	// indexing height 5 prunes heights 1 and 2
+	require.NoError(t, idx.Index(5, bytes.Repeat([]byte{5}, 32), "signer", []*types.ExtensionData{dex}))
+	txs, _, err = idx.TxsByProtocol("dex", 0, 0, nil, 10)
+	require.NoError(t, err)
+	require.Len(t, txs, 2)
+	require.Equal(t, int64(3), txs[0].Height)
+	require.Equal(t, int64(5), txs[1].Height)
+
+	txs, _, err = idx.TxsByProtocol("other", 0, 0, nil, 10)
+	require.NoError(t, err)
+	require.Len(t, txs, 1)
+}
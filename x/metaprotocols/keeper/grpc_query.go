@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/maany-xyz/maany-provider/x/metaprotocols/index"
+	"github.com/maany-xyz/maany-provider/x/metaprotocols/types"
+)
+
+// queryServer implements types.QueryServer. Registry queries read consensus
+// state, TxsByProtocol is served by the node-local index.
+type queryServer struct {
+	Keeper
+	index.Querier
+}
+
+// Ensure queryServer implements the generated QueryServer.
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServer returns a types.QueryServer backed by the keeper and, for
+// TxsByProtocol, by indexer, which is nil if indexing is disabled.
+func NewQueryServer(k Keeper, indexer *index.Indexer) types.QueryServer {
+	return queryServer{Keeper: k, Querier: index.NewQuerier(indexer)}
+}
+
+// Params returns the module parameters.
+func (q queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	return &types.QueryParamsResponse{Params: q.GetParams(sdk.UnwrapSDKContext(ctx))}, nil
+}
+
+// Protocol returns the registry entry of a protocol.
+func (q queryServer) Protocol(ctx context.Context, req *types.QueryProtocolRequest) (*types.QueryProtocolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	p, found := q.GetProtocol(sdk.UnwrapSDKContext(ctx), req.ProtocolId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "protocol %s is not registered", req.ProtocolId)
+	}
+	return &types.QueryProtocolResponse{Protocol: p}, nil
+}
+
+// Protocols returns the registry entries.
+func (q queryServer) Protocols(ctx context.Context, req *types.QueryProtocolsRequest) (*types.QueryProtocolsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	store := prefix.NewStore(sdk.UnwrapSDKContext(ctx).KVStore(q.storeKey), types.ProtocolPrefix)
+	protocols := []types.RegisteredProtocol{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var p types.RegisteredProtocol
+		if err := q.cdc.Unmarshal(value, &p); err != nil {
+			return err
+		}
+		protocols = append(protocols, p)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryProtocolsResponse{Protocols: protocols, Pagination: pageRes}, nil
+}
@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-provider/x/metaprotocols/types"
+)
+
+// Keeper maintains the governance-managed registry of metaprotocols.
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+
+	// the address capable of changing the registry and params, typically x/gov
+	authority string
+}
+
+// NewKeeper creates a new metaprotocols Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, authority string) Keeper {
+	return Keeper{
+		cdc:       cdc,
+		storeKey:  storeKey,
+		authority: authority,
+	}
+}
+
+// GetAuthority returns the module authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the module parameters, or the defaults if none are set.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	bz := ctx.KVStore(k.storeKey).Get(types.ParamsKey)
+	if bz == nil {
+		return types.DefaultParams()
+	}
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return fmt.Errorf("invalid params: %w", err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.ParamsKey, k.cdc.MustMarshal(&params))
+	return nil
+}
+
+// GetProtocol returns the registry entry of a protocol.
+func (k Keeper) GetProtocol(ctx sdk.Context, protocolID string) (types.RegisteredProtocol, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ProtocolKey(protocolID))
+	if bz == nil {
+		return types.RegisteredProtocol{}, false
+	}
+	var p types.RegisteredProtocol
+	k.cdc.MustUnmarshal(bz, &p)
+	return p, true
+}
+
+// SetProtocol adds or replaces a registry entry.
+func (k Keeper) SetProtocol(ctx sdk.Context, p types.RegisteredProtocol) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.ProtocolKey(p.ProtocolId), k.cdc.MustMarshal(&p))
+	return nil
+}
+
+// DeleteProtocol removes a registry entry.
+func (k Keeper) DeleteProtocol(ctx sdk.Context, protocolID string) {
+	ctx.KVStore(k.storeKey).Delete(types.ProtocolKey(protocolID))
+}
+
+// IterateProtocols walks the registry in protocol id order.
+func (k Keeper) IterateProtocols(ctx sdk.Context, cb func(p types.RegisteredProtocol) (stop bool)) {
+	ps := prefix.NewStore(ctx.KVStore(k.storeKey), types.ProtocolPrefix)
+	it := ps.Iterator(nil, nil)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var p types.RegisteredProtocol
+		k.cdc.MustUnmarshal(it.Value(), &p)
+		if cb(p) {
+			return
+		}
+	}
+}
+
+// InitGenesis stores the params and registry of the genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
+	if err := k.SetParams(ctx, gs.Params); err != nil {
+		panic(err)
+	}
+	for _, p := range gs.Protocols {
+		if err := k.SetProtocol(ctx, p); err != nil {
+			panic(fmt.Sprintf("invalid protocol in genesis: %v", err))
+		}
+	}
+}
+
+// ExportGenesis returns the params and registry as a genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	protocols := []types.RegisteredProtocol{}
+	k.IterateProtocols(ctx, func(p types.RegisteredProtocol) bool {
+		protocols = append(protocols, p)
+		return false
+	})
+	return &types.GenesisState{
+		Params:    k.GetParams(ctx),
+		Protocols: protocols,
+	}
+}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/maany-xyz/maany-provider/x/metaprotocols/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// Ensure msgServer implements the generated MsgServer.
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the metaprotocols MsgServer.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return msgServer{k}
+}
+
+func (m msgServer) checkAuthority(authority string) error {
+	if authority != m.authority {
+		return errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.authority, authority)
+	}
+	return nil
+}
+
+// RegisterProtocol adds or replaces a registry entry.
+func (m msgServer) RegisterProtocol(goCtx context.Context, msg *types.MsgRegisterProtocol) (*types.MsgRegisterProtocolResponse, error) {
+	if err := m.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	// a proto schema must be linked into the binary to be enforceable
+	if msg.Protocol.SchemaType == types.SCHEMA_TYPE_PROTO && proto.MessageType(msg.Protocol.SchemaRef) == nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unknown protobuf message %s", msg.Protocol.SchemaRef)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.SetProtocol(ctx, msg.Protocol); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.MsgRegisterProtocolResponse{}, nil
+}
+
+// RemoveProtocol removes a registry entry.
+func (m msgServer) RemoveProtocol(goCtx context.Context, msg *types.MsgRemoveProtocol) (*types.MsgRemoveProtocolResponse, error) {
+	if err := m.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := m.GetProtocol(ctx, msg.ProtocolId); !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "protocol %s is not registered", msg.ProtocolId)
+	}
+	m.DeleteProtocol(ctx, msg.ProtocolId)
+	return &types.MsgRemoveProtocolResponse{}, nil
+}
+
+// UpdateParams replaces the module parameters.
+func (m msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if err := m.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.SetParams(ctx, msg.Params); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.MsgUpdateParamsResponse{}, nil
+}
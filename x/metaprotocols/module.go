@@ -2,6 +2,7 @@ package metaprotocols
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -9,12 +10,16 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 
+	"github.com/maany-xyz/maany-provider/x/metaprotocols/index"
+	"github.com/maany-xyz/maany-provider/x/metaprotocols/keeper"
 	"github.com/maany-xyz/maany-provider/x/metaprotocols/types"
 )
 
@@ -32,20 +37,29 @@ func (a AppModuleBasic) Name() string {
 	return types.ModuleName
 }
 
-// DefaultGenesis is an empty object
-func (AppModuleBasic) DefaultGenesis(_ codec.JSONCodec) json.RawMessage {
-	return []byte("{}")
+// DefaultGenesis has an empty protocol registry
+func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(types.DefaultGenesisState())
 }
 
-func (AppModuleBasic) ValidateGenesis(_ codec.JSONCodec, config client.TxEncodingConfig, _ json.RawMessage) error {
-	return nil
+func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage) error {
+	var gs types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &gs); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+	}
+	return gs.Validate()
 }
 
-func (a AppModule) ExportGenesis(_ sdk.Context, cdc codec.JSONCodec) json.RawMessage {
-	return a.DefaultGenesis(cdc)
+func (a AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(a.keeper.ExportGenesis(ctx))
 }
 
-func (a AppModule) InitGenesis(ctx sdk.Context, marshaler codec.JSONCodec, message json.RawMessage) []abci.ValidatorUpdate {
+// InitGenesis accepts the empty object of genesis files written before the
+// registry existed.
+func (a AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, bz json.RawMessage) []abci.ValidatorUpdate {
+	var gs types.GenesisState
+	cdc.MustUnmarshalJSON(bz, &gs)
+	a.keeper.InitGenesis(ctx, gs)
 	return nil
 }
 
@@ -74,16 +88,81 @@ func (a AppModuleBasic) RegisterLegacyAminoCodec(_ *codec.LegacyAmino) {
 
 type AppModule struct {
 	AppModuleBasic
+
+	keeper keeper.Keeper
+
+	// indexer is nil unless extension data indexing is enabled on this node
+	indexer *index.Indexer
 }
 
-func NewAppModule() *AppModule {
-	return &AppModule{}
+func NewAppModule(k keeper.Keeper, indexer *index.Indexer) *AppModule {
+	return &AppModule{keeper: k, indexer: indexer}
 }
 
 func (a AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {
 }
 
-func (a AppModule) RegisterServices(_ module.Configurator) {
+func (a AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(a.keeper))
+	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServer(a.keeper, a.indexer))
+}
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (a AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: "maany.metaprotocols.Query",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the metaprotocols parameters",
+				},
+				{
+					RpcMethod: "Protocol",
+					Use:       "protocol [protocol-id]",
+					Short:     "Query the registry entry of a protocol",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "protocol_id"},
+					},
+				},
+				{
+					RpcMethod: "Protocols",
+					Use:       "protocols",
+					Short:     "List the registered protocols",
+				},
+				{
+					RpcMethod: "TxsByProtocol",
+					Use:       "txs-by-protocol [protocol-id]",
+					Short:     "List the txs that attached extension data of a protocol, from the node-local index",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "protocol_id"},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"min_height": {Name: "min-height", Usage: "First height to include"},
+						"max_height": {Name: "max-height", Usage: "Last height to include"},
+					},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: "maany.metaprotocols.Msg",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "RegisterProtocol",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "RemoveProtocol",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+			},
+		},
+	}
 }
 
 func (a AppModule) BeginBlock(_ sdk.Context) {
@@ -2,6 +2,7 @@ package types
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	tx "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 )
@@ -16,4 +17,11 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		// the app does not interact with this message in any way but it performs an unmarshal which must not fail
 		&authz.MsgRevoke{},
 	)
+
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgRegisterProtocol{},
+		&MsgRemoveProtocol{},
+		&MsgUpdateParams{},
+	)
 }
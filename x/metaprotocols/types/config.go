@@ -0,0 +1,65 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const (
+	flagIndexEnabled      = "metaprotocols.index-enabled"
+	flagIndexRetainBlocks = "metaprotocols.index-retain-blocks"
+
+	// DefaultIndexRetainBlocks keeps roughly one week of 6s blocks.
+	DefaultIndexRetainBlocks uint64 = 100800
+)
+
+// IndexConfig is the app.toml configuration of the node-local extension data
+// index. The index is not part of consensus state and is disabled by default.
+type IndexConfig struct {
+	// Enabled turns on recording of txs carrying ExtensionData.
+	Enabled bool `mapstructure:"index-enabled"`
+	// RetainBlocks is the number of recent blocks kept in the index. 0 keeps
+	// everything.
+	RetainBlocks uint64 `mapstructure:"index-retain-blocks"`
+}
+
+// DefaultIndexConfig returns the default index configuration.
+func DefaultIndexConfig() IndexConfig {
+	return IndexConfig{
+		Enabled:      false,
+		RetainBlocks: DefaultIndexRetainBlocks,
+	}
+}
+
+// ReadIndexConfig reads the index configuration from the app options.
+func ReadIndexConfig(opts servertypes.AppOptions) (IndexConfig, error) {
+	cfg := DefaultIndexConfig()
+	var err error
+	if v := opts.Get(flagIndexEnabled); v != nil {
+		if cfg.Enabled, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagIndexRetainBlocks); v != nil {
+		if cfg.RetainBlocks, err = cast.ToUint64E(v); err != nil {
+			return cfg, err
+		}
+	}
+	return cfg, nil
+}
+
+// IndexConfigTemplate is the app.toml snippet of the index configuration.
+func IndexConfigTemplate(c IndexConfig) string {
+	return fmt.Sprintf(`
+[metaprotocols]
+# Record txs carrying ExtensionData in a node-local index that can be queried
+# by protocol id and height range. The index is not part of consensus state.
+index-enabled = %t
+
+# Number of recent blocks kept in the index. 0 keeps everything.
+index-retain-blocks = %d
+`, c.Enabled, c.RetainBlocks)
+}
@@ -0,0 +1,29 @@
+package types
+
+import "fmt"
+
+// DefaultGenesisState returns the default genesis state, an empty registry.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:    DefaultParams(),
+		Protocols: []RegisteredProtocol{},
+	}
+}
+
+// Validate checks the validity of the GenesisState.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return fmt.Errorf("invalid params: %w", err)
+	}
+	seen := make(map[string]bool, len(gs.Protocols))
+	for _, p := range gs.Protocols {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+		if seen[p.ProtocolId] {
+			return fmt.Errorf("duplicate registry entry for protocol %s", p.ProtocolId)
+		}
+		seen[p.ProtocolId] = true
+	}
+	return nil
+}
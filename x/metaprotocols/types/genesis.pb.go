@@ -0,0 +1,967 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: maany/metaprotocols/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SchemaType selects how the data of a registered protocol is checked.
+type SchemaType int32
+
+const (
+	// SCHEMA_TYPE_NONE accepts any data.
+	SCHEMA_TYPE_NONE SchemaType = 0
+	// SCHEMA_TYPE_JSON requires the data to be a valid JSON document. The
+	// schema_ref points to the JSON schema the protocol publishes.
+	SCHEMA_TYPE_JSON SchemaType = 1
+	// SCHEMA_TYPE_PROTO requires the data to decode into the protobuf message
+	// whose full name is the schema_ref.
+	SCHEMA_TYPE_PROTO SchemaType = 2
+)
+
+var SchemaType_name = map[int32]string{
+	0: "SCHEMA_TYPE_NONE",
+	1: "SCHEMA_TYPE_JSON",
+	2: "SCHEMA_TYPE_PROTO",
+}
+
+var SchemaType_value = map[string]int32{
+	"SCHEMA_TYPE_NONE":  0,
+	"SCHEMA_TYPE_JSON":  1,
+	"SCHEMA_TYPE_PROTO": 2,
+}
+
+func (x SchemaType) String() string {
+	return proto.EnumName(SchemaType_name, int32(x))
+}
+
+func (SchemaType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5f0cc34ee9e2c57f, []int{0}
+}
+
+// RegisteredProtocol is an entry of the governance-managed protocol registry.
+type RegisteredProtocol struct {
+	ProtocolId string `protobuf:"bytes,1,opt,name=protocol_id,json=protocolId,proto3" json:"protocol_id,omitempty"`
+	// max_data_size is the maximum length in bytes of the ExtensionData data.
+	MaxDataSize uint64     `protobuf:"varint,2,opt,name=max_data_size,json=maxDataSize,proto3" json:"max_data_size,omitempty"`
+	SchemaType  SchemaType `protobuf:"varint,3,opt,name=schema_type,json=schemaType,proto3,enum=maany.metaprotocols.SchemaType" json:"schema_type,omitempty"`
+	// schema_ref is the JSON schema URI or the protobuf message name of the
+	// data, depending on schema_type.
+	SchemaRef string `protobuf:"bytes,4,opt,name=schema_ref,json=schemaRef,proto3" json:"schema_ref,omitempty"`
+	// extra_gas is charged for every ExtensionData of the protocol in a tx,
+	// on top of the tx size gas.
+	ExtraGas uint64 `protobuf:"varint,5,opt,name=extra_gas,json=extraGas,proto3" json:"extra_gas,omitempty"`
+}
+
+func (m *RegisteredProtocol) Reset()         { *m = RegisteredProtocol{} }
+func (m *RegisteredProtocol) String() string { return proto.CompactTextString(m) }
+func (*RegisteredProtocol) ProtoMessage()    {}
+func (*RegisteredProtocol) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f0cc34ee9e2c57f, []int{0}
+}
+func (m *RegisteredProtocol) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisteredProtocol) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisteredProtocol.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegisteredProtocol) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisteredProtocol.Merge(m, src)
+}
+func (m *RegisteredProtocol) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisteredProtocol) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisteredProtocol.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisteredProtocol proto.InternalMessageInfo
+
+func (m *RegisteredProtocol) GetProtocolId() string {
+	if m != nil {
+		return m.ProtocolId
+	}
+	return ""
+}
+
+func (m *RegisteredProtocol) GetMaxDataSize() uint64 {
+	if m != nil {
+		return m.MaxDataSize
+	}
+	return 0
+}
+
+func (m *RegisteredProtocol) GetSchemaType() SchemaType {
+	if m != nil {
+		return m.SchemaType
+	}
+	return SCHEMA_TYPE_NONE
+}
+
+func (m *RegisteredProtocol) GetSchemaRef() string {
+	if m != nil {
+		return m.SchemaRef
+	}
+	return ""
+}
+
+func (m *RegisteredProtocol) GetExtraGas() uint64 {
+	if m != nil {
+		return m.ExtraGas
+	}
+	return 0
+}
+
+// Params defines the parameters for the metaprotocols module.
+type Params struct {
+	// reject_unregistered rejects txs with ExtensionData of protocols that are
+	// not in the registry.
+	RejectUnregistered bool `protobuf:"varint,1,opt,name=reject_unregistered,json=rejectUnregistered,proto3" json:"reject_unregistered,omitempty"`
+	// unregistered_max_data_size is the maximum data length in bytes of
+	// ExtensionData of unregistered protocols, when they are accepted.
+	// 0 means no limit.
+	UnregisteredMaxDataSize uint64 `protobuf:"varint,2,opt,name=unregistered_max_data_size,json=unregisteredMaxDataSize,proto3" json:"unregistered_max_data_size,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f0cc34ee9e2c57f, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetRejectUnregistered() bool {
+	if m != nil {
+		return m.RejectUnregistered
+	}
+	return false
+}
+
+func (m *Params) GetUnregisteredMaxDataSize() uint64 {
+	if m != nil {
+		return m.UnregisteredMaxDataSize
+	}
+	return 0
+}
+
+// GenesisState defines the genesis state of the metaprotocols module.
+type GenesisState struct {
+	Params    Params               `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Protocols []RegisteredProtocol `protobuf:"bytes,2,rep,name=protocols,proto3" json:"protocols"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f0cc34ee9e2c57f, []int{2}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetProtocols() []RegisteredProtocol {
+	if m != nil {
+		return m.Protocols
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("maany.metaprotocols.SchemaType", SchemaType_name, SchemaType_value)
+	proto.RegisterType((*RegisteredProtocol)(nil), "maany.metaprotocols.RegisteredProtocol")
+	proto.RegisterType((*Params)(nil), "maany.metaprotocols.Params")
+	proto.RegisterType((*GenesisState)(nil), "maany.metaprotocols.GenesisState")
+}
+
+func init() { proto.RegisterFile("maany/metaprotocols/genesis.proto", fileDescriptor_5f0cc34ee9e2c57f) }
+
+var fileDescriptor_5f0cc34ee9e2c57f = []byte{
+	// 455 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0xe3, 0xae, 0x54, 0xeb, 0x1b, 0x40, 0xc5, 0x1b, 0x22, 0xea, 0x44, 0x5a, 0x7a, 0xa1,
+	0x42, 0x22, 0x91, 0xca, 0x69, 0xe2, 0x02, 0x83, 0x6a, 0xfc, 0xd1, 0xda, 0x2a, 0xe9, 0x0e, 0x70,
+	0xb1, 0xbc, 0xe6, 0x5d, 0x16, 0x44, 0x9a, 0xc8, 0xf6, 0xa6, 0xb4, 0x9f, 0x80, 0x23, 0x27, 0xbe,
+	0x00, 0x5f, 0x66, 0xc7, 0xdd, 0xe0, 0x84, 0x50, 0xfb, 0x45, 0xd0, 0x9c, 0xac, 0x2d, 0x5d, 0x6f,
+	0xaf, 0x1f, 0xff, 0x9e, 0xe4, 0x79, 0x6c, 0xc3, 0x93, 0x98, 0xf3, 0xf1, 0xc4, 0x8d, 0x51, 0xf1,
+	0x54, 0x24, 0x2a, 0x19, 0x25, 0x5f, 0xa5, 0x1b, 0xe2, 0x18, 0x65, 0x24, 0x1d, 0xad, 0xd0, 0x1d,
+	0x8d, 0x38, 0xff, 0x21, 0xf5, 0xdd, 0x30, 0x09, 0x13, 0xbd, 0x74, 0xaf, 0xa7, 0x1c, 0x6d, 0xfd,
+	0x22, 0x40, 0x3d, 0x0c, 0x23, 0xa9, 0x50, 0x60, 0x30, 0x28, 0x68, 0xda, 0x00, 0xf3, 0xc6, 0xc9,
+	0xa2, 0xc0, 0x22, 0x4d, 0xd2, 0xae, 0x7a, 0x70, 0x23, 0xbd, 0x0f, 0x68, 0x0b, 0xee, 0xc5, 0x3c,
+	0x63, 0x01, 0x57, 0x9c, 0xc9, 0x68, 0x8a, 0x56, 0xa9, 0x49, 0xda, 0x65, 0xcf, 0x8c, 0x79, 0xf6,
+	0x96, 0x2b, 0xee, 0x47, 0x53, 0xa4, 0xaf, 0xc0, 0x94, 0xa3, 0x33, 0x8c, 0x39, 0x53, 0x93, 0x14,
+	0xad, 0xad, 0x26, 0x69, 0xdf, 0xef, 0x34, 0x9c, 0x0d, 0xe1, 0x1c, 0x5f, 0x73, 0xc3, 0x49, 0x8a,
+	0x1e, 0xc8, 0xc5, 0x4c, 0x1f, 0x43, 0xb1, 0x62, 0x02, 0x4f, 0xad, 0xb2, 0x4e, 0x51, 0xcd, 0x15,
+	0x0f, 0x4f, 0xe9, 0x1e, 0x54, 0x31, 0x53, 0x82, 0xb3, 0x90, 0x4b, 0xeb, 0x8e, 0x0e, 0xb0, 0xad,
+	0x85, 0x43, 0x2e, 0x5b, 0x17, 0x50, 0x19, 0x70, 0xc1, 0x63, 0x49, 0x5d, 0xd8, 0x11, 0xf8, 0x05,
+	0x47, 0x8a, 0x9d, 0x8f, 0xc5, 0xa2, 0xab, 0x2e, 0xb5, 0xed, 0xd1, 0x7c, 0xeb, 0x78, 0x65, 0x87,
+	0xbe, 0x84, 0xfa, 0x2a, 0xc9, 0x36, 0x35, 0x7d, 0xb4, 0x4a, 0x1c, 0x2d, 0x5b, 0xb7, 0x7e, 0x10,
+	0xb8, 0x7b, 0x98, 0x5f, 0x87, 0xaf, 0xb8, 0x42, 0xba, 0x0f, 0x95, 0x54, 0x07, 0xd1, 0x7f, 0x34,
+	0x3b, 0x7b, 0x1b, 0x4f, 0x20, 0xcf, 0x7a, 0x50, 0xbe, 0xfc, 0xd3, 0x30, 0xbc, 0xc2, 0x40, 0x3f,
+	0x42, 0x75, 0x41, 0x58, 0xa5, 0xe6, 0x56, 0xdb, 0xec, 0x3c, 0xdd, 0xe8, 0xbe, 0x7d, 0x85, 0xc5,
+	0x97, 0x96, 0xfe, 0x67, 0xc7, 0x00, 0xcb, 0x63, 0xa6, 0xbb, 0x50, 0xf3, 0xdf, 0xbc, 0xeb, 0x1e,
+	0xbd, 0x66, 0xc3, 0x4f, 0x83, 0x2e, 0xeb, 0xf5, 0x7b, 0xdd, 0x9a, 0xb1, 0xae, 0x7e, 0xf0, 0xfb,
+	0xbd, 0x1a, 0xa1, 0x0f, 0xe1, 0xc1, 0xaa, 0x3a, 0xf0, 0xfa, 0xc3, 0x7e, 0xad, 0x54, 0x2f, 0x7f,
+	0xfb, 0x69, 0x1b, 0x07, 0xfe, 0xe5, 0xcc, 0x26, 0x57, 0x33, 0x9b, 0xfc, 0x9d, 0xd9, 0xe4, 0xfb,
+	0xdc, 0x36, 0xae, 0xe6, 0xb6, 0xf1, 0x7b, 0x6e, 0x1b, 0x9f, 0xf7, 0xc3, 0x48, 0x9d, 0x9d, 0x9f,
+	0x38, 0xa3, 0x24, 0x76, 0x75, 0xe8, 0xe7, 0xd9, 0x64, 0x5a, 0x4c, 0xa9, 0x48, 0x2e, 0xa2, 0x00,
+	0x85, 0x9b, 0xad, 0xbd, 0xe4, 0xeb, 0xa7, 0x22, 0x4f, 0x2a, 0x5a, 0x78, 0xf1, 0x6f, 0x00, 0xf6,
+	0x60, 0xf1, 0x69, 0xed, 0x02, 0x00, 0x00,
+}
+
+func (m *RegisteredProtocol) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisteredProtocol) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisteredProtocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExtraGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ExtraGas))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.SchemaRef) > 0 {
+		i -= len(m.SchemaRef)
+		copy(dAtA[i:], m.SchemaRef)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.SchemaRef)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SchemaType != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SchemaType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxDataSize != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxDataSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProtocolId) > 0 {
+		i -= len(m.ProtocolId)
+		copy(dAtA[i:], m.ProtocolId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ProtocolId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnregisteredMaxDataSize != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UnregisteredMaxDataSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RejectUnregistered {
+		i--
+		if m.RejectUnregistered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Protocols) > 0 {
+		for iNdEx := len(m.Protocols) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Protocols[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RegisteredProtocol) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProtocolId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.MaxDataSize != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxDataSize))
+	}
+	if m.SchemaType != 0 {
+		n += 1 + sovGenesis(uint64(m.SchemaType))
+	}
+	l = len(m.SchemaRef)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.ExtraGas != 0 {
+		n += 1 + sovGenesis(uint64(m.ExtraGas))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RejectUnregistered {
+		n += 2
+	}
+	if m.UnregisteredMaxDataSize != 0 {
+		n += 1 + sovGenesis(uint64(m.UnregisteredMaxDataSize))
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Protocols) > 0 {
+		for _, e := range m.Protocols {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RegisteredProtocol) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisteredProtocol: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisteredProtocol: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDataSize", wireType)
+			}
+			m.MaxDataSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDataSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaType", wireType)
+			}
+			m.SchemaType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SchemaType |= SchemaType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaRef", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SchemaRef = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraGas", wireType)
+			}
+			m.ExtraGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtraGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectUnregistered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RejectUnregistered = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnregisteredMaxDataSize", wireType)
+			}
+			m.UnregisteredMaxDataSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnregisteredMaxDataSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocols", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Protocols = append(m.Protocols, RegisteredProtocol{})
+			if err := m.Protocols[len(m.Protocols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
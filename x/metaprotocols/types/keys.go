@@ -2,4 +2,20 @@ package types
 
 const (
 	ModuleName = "metaprotocols"
+
+	// StoreKey is the store key of the protocol registry
+	StoreKey = ModuleName
+
+	// MaxProtocolIDLength is the maximum length of a registered protocol id
+	MaxProtocolIDLength = 255
 )
+
+var (
+	ParamsKey      = []byte{0x00}
+	ProtocolPrefix = []byte{0x01}
+)
+
+// ProtocolKey returns the registry key of a protocol.
+func ProtocolKey(protocolID string) []byte {
+	return append(append([]byte{}, ProtocolPrefix...), protocolID...)
+}
@@ -0,0 +1,15 @@
+package types
+
+// DefaultParams accepts ExtensionData of any protocol, as before the registry
+// existed.
+func DefaultParams() Params {
+	return Params{
+		RejectUnregistered:      false,
+		UnregisteredMaxDataSize: 0,
+	}
+}
+
+// Validate performs validation on the metaprotocols parameters.
+func (p Params) Validate() error {
+	return nil
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// Validate checks a registry entry.
+func (p RegisteredProtocol) Validate() error {
+	if strings.TrimSpace(p.ProtocolId) == "" {
+		return fmt.Errorf("protocol id cannot be blank")
+	}
+	if len(p.ProtocolId) > MaxProtocolIDLength {
+		return fmt.Errorf("protocol id is longer than %d bytes", MaxProtocolIDLength)
+	}
+	if p.MaxDataSize == 0 {
+		return fmt.Errorf("max data size of protocol %s must be positive", p.ProtocolId)
+	}
+	switch p.SchemaType {
+	case SCHEMA_TYPE_NONE, SCHEMA_TYPE_JSON:
+	case SCHEMA_TYPE_PROTO:
+		if p.SchemaRef == "" {
+			return fmt.Errorf("protocol %s requires a protobuf message name as schema reference", p.ProtocolId)
+		}
+	default:
+		return fmt.Errorf("unknown schema type %d of protocol %s", p.SchemaType, p.ProtocolId)
+	}
+	return nil
+}
+
+// ValidateData checks ExtensionData of the protocol against its size limit
+// and schema.
+func (p RegisteredProtocol) ValidateData(data []byte) error {
+	if uint64(len(data)) > p.MaxDataSize {
+		return fmt.Errorf("data of protocol %s is %d bytes, max is %d", p.ProtocolId, len(data), p.MaxDataSize)
+	}
+
+	switch p.SchemaType {
+	case SCHEMA_TYPE_JSON:
+		if !json.Valid(data) {
+			return fmt.Errorf("data of protocol %s is not valid JSON", p.ProtocolId)
+		}
+	case SCHEMA_TYPE_PROTO:
+		msgType := proto.MessageType(p.SchemaRef)
+		if msgType == nil {
+			return fmt.Errorf("schema %s of protocol %s is not a known protobuf message", p.SchemaRef, p.ProtocolId)
+		}
+		if msgType.Kind() == reflect.Ptr {
+			msgType = msgType.Elem()
+		}
+		msg, ok := reflect.New(msgType).Interface().(proto.Message)
+		if !ok {
+			return fmt.Errorf("schema %s of protocol %s is not a protobuf message", p.SchemaRef, p.ProtocolId)
+		}
+		if err := proto.Unmarshal(data, msg); err != nil {
+			return fmt.Errorf("data of protocol %s does not decode as %s: %w", p.ProtocolId, p.SchemaRef, err)
+		}
+	}
+	return nil
+}
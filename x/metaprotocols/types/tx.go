@@ -0,0 +1,32 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// ExtensionDataFromTx returns the ExtensionData found in the non critical
+// extension options of a tx.
+func ExtensionDataFromTx(tx sdk.Tx) []*ExtensionData {
+	extTx, ok := tx.(interface {
+		GetNonCriticalExtensionOptions() []*codectypes.Any
+	})
+	if !ok {
+		return nil
+	}
+
+	typeURL := "/" + proto.MessageName(&ExtensionData{})
+	var exts []*ExtensionData
+	for _, opt := range extTx.GetNonCriticalExtensionOptions() {
+		if opt == nil || opt.TypeUrl != typeURL {
+			continue
+		}
+		ext := &ExtensionData{}
+		if err := ext.Unmarshal(opt.Value); err != nil {
+			continue
+		}
+		exts = append(exts, ext)
+	}
+	return exts
+}
@@ -0,0 +1,327 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/jsonpb"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+
+	addressutil "github.com/maany-xyz/maany-provider/pkg/address"
+	mintburntypes "github.com/maany-xyz/maany-provider/x/mintburn/types"
+)
+
+const (
+	flagProviderClientID          = "provider-client-id"
+	flagMintDenom                 = "mint-denom"
+	flagBundleRecipient           = "recipient"
+	flagConsumerAccountPrefix     = "consumer-account-prefix"
+	flagICAControllerConnectionID = "ica-controller-connection-id"
+	flagICAOwner                  = "ica-owner"
+	flagICATxTimeoutSeconds       = "ica-tx-timeout-seconds"
+	flagICAMaxClaimPerBlock       = "ica-max-claim-per-block"
+	flagBundleOutput              = "output-file"
+	flagBundleWait                = "wait"
+)
+
+// LaunchBundle is the genesismint section of a consumer genesis, seeded with
+// a proven provider escrow.
+type LaunchBundle struct {
+	Params           LaunchBundleParams `json:"params"`
+	Mints            []LaunchBundleMint `json:"mints"`
+	ClaimedEscrowIDs []string           `json:"claimedEscrowIds"`
+}
+
+type LaunchBundleParams struct {
+	ProviderClientID          string             `json:"providerClientId"`
+	ProviderChainID           string             `json:"providerChainId"`
+	AllowedProviderDenom      string             `json:"allowedProviderDenom"`
+	MintDenom                 string             `json:"mintDenom"`
+	GenesisTrustedRoot        GenesisTrustedRoot `json:"genesisTrustedRoot"`
+	UseGenesisTrustedRoot     bool               `json:"useGenesisTrustedRoot"`
+	ICAControllerConnectionID string             `json:"icaControllerConnectionId"`
+	ICAOwner                  string             `json:"icaOwner"`
+	ICATxTimeoutSeconds       string             `json:"icaTxTimeoutSeconds"`
+	ICAMaxClaimPerBlock       string             `json:"icaMaxClaimPerBlock"`
+}
+
+type GenesisTrustedRoot struct {
+	RevisionNumber string `json:"revisionNumber"`
+	RevisionHeight string `json:"revisionHeight"`
+	// Hash is the app hash the proof verifies against, base64 encoded.
+	Hash string `json:"hash"`
+}
+
+type LaunchBundleMint struct {
+	MerkleProof               json.RawMessage `json:"merkleProof"`
+	ProofHeightRevisionNumber uint64          `json:"proofHeightRevisionNumber"`
+	ProofHeightRevisionHeight uint64          `json:"proofHeightRevisionHeight"`
+	KeyPath                   []string        `json:"keyPath"`
+	// Value is the committed escrow, base64 encoded.
+	Value           string `json:"value"`
+	ProviderChainID string `json:"providerChainId"`
+	AmountDenom     string `json:"amountDenom"`
+	AmountValue     string `json:"amountValue"`
+	EscrowID        string `json:"escrowId"`
+	Recipient       string `json:"recipient"`
+}
+
+// NewLaunchBundleCmd returns the launch-bundle command, which proves a pending
+// escrow and writes the genesismint bundle of the consumer genesis.
+func NewLaunchBundleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "launch-bundle [consumer-chain-id] [denom]",
+		Short: "Prove a pending escrow and write the genesismint bundle for the consumer genesis",
+		Long: `Wait for the pending escrow of a consumer chain, fetch the committed escrow and
+its proof at --height (the latest height if unset), verify the proof locally
+against the app hash in the header of the next block and write the genesismint
+bundle to copy into the consumer genesis.
+
+Nothing is signed or broadcast, run escrow-initial first.`,
+		Example: `maanypd tx mintburn launch-bundle maanydex umaany --provider-client-id 07-tendermint-0 \
+  --recipient maany-dex1... --ica-controller-connection-id connection-0 --ica-owner maany-dex1... \
+  --ica-tx-timeout-seconds 600 --ica-max-claim-per-block 10 --output-file bundle.json`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			consumerChainID, denom := args[0], args[1]
+
+			params := LaunchBundleParams{AllowedProviderDenom: denom, UseGenesisTrustedRoot: true}
+			if params.ProviderClientID, err = cmd.Flags().GetString(flagProviderClientID); err != nil {
+				return err
+			}
+			if params.MintDenom, err = cmd.Flags().GetString(flagMintDenom); err != nil {
+				return err
+			}
+			if params.MintDenom == "" {
+				params.MintDenom = denom
+			}
+			if params.ICAControllerConnectionID, err = cmd.Flags().GetString(flagICAControllerConnectionID); err != nil {
+				return err
+			}
+			if params.ICAOwner, err = cmd.Flags().GetString(flagICAOwner); err != nil {
+				return err
+			}
+			icaTimeout, err := cmd.Flags().GetUint64(flagICATxTimeoutSeconds)
+			if err != nil {
+				return err
+			}
+			icaMaxClaim, err := cmd.Flags().GetUint64(flagICAMaxClaimPerBlock)
+			if err != nil {
+				return err
+			}
+			params.ICATxTimeoutSeconds = strconv.FormatUint(icaTimeout, 10)
+			params.ICAMaxClaimPerBlock = strconv.FormatUint(icaMaxClaim, 10)
+			recipient, err := cmd.Flags().GetString(flagBundleRecipient)
+			if err != nil {
+				return err
+			}
+			consumerPrefix, err := cmd.Flags().GetString(flagConsumerAccountPrefix)
+			if err != nil {
+				return err
+			}
+			if recipient == "" && consumerPrefix == "" {
+				return fmt.Errorf("set --%s, or --%s to use the escrow recipient", flagBundleRecipient, flagConsumerAccountPrefix)
+			}
+			wait, err := cmd.Flags().GetDuration(flagBundleWait)
+			if err != nil {
+				return err
+			}
+			output, err := cmd.Flags().GetString(flagBundleOutput)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), wait)
+			defer cancel()
+
+			escrow, err := waitForPendingEscrow(ctx, clientCtx, consumerChainID, denom)
+			if err != nil {
+				return err
+			}
+			if recipient, err = bundleRecipient(recipient, escrow, consumerPrefix); err != nil {
+				return err
+			}
+
+			node, err := clientCtx.GetNode()
+			if err != nil {
+				return err
+			}
+			status, err := node.Status(ctx)
+			if err != nil {
+				return err
+			}
+			params.ProviderChainID = status.NodeInfo.Network
+			// heights on the provider chain carry the revision of its chain id
+			revision := clienttypes.ParseChainID(params.ProviderChainID)
+			height := clientCtx.Height
+			if height == 0 {
+				height = status.SyncInfo.LatestBlockHeight
+			}
+
+			key := mintburntypes.EscrowKeyByID(escrow.EscrowId)
+			res, err := clientCtx.QueryABCI(abci.RequestQuery{
+				Path:   fmt.Sprintf("/store/%s/key", mintburntypes.StoreKey),
+				Data:   key,
+				Height: height,
+				Prove:  true,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to query the escrow proof at height %d: %w", height, err)
+			}
+			if len(res.Value) == 0 {
+				return fmt.Errorf("escrow %s is not committed at height %d", escrow.EscrowId, height)
+			}
+			proof, err := commitmenttypes.ConvertProofs(res.ProofOps)
+			if err != nil {
+				return fmt.Errorf("failed to convert the escrow proof: %w", err)
+			}
+
+			// the app hash of the state at height is in the header of the next block
+			appHash, err := waitForAppHash(ctx, clientCtx, height+1)
+			if err != nil {
+				return err
+			}
+			proven, err := VerifyEscrowProof(clientCtx.Codec, proof, appHash, key, res.Value)
+			if err != nil {
+				return err
+			}
+			if proven.Status != mintburntypes.EscrowStatus_ESCROW_STATUS_PENDING ||
+				proven.ConsumerChainId != consumerChainID || proven.Amount.Denom != denom {
+				return fmt.Errorf("escrow %s at height %d is not the pending %s escrow of %s", proven.EscrowId, height, denom, consumerChainID)
+			}
+
+			var proofJSON bytes.Buffer
+			if err := (&jsonpb.Marshaler{}).Marshal(&proofJSON, &proof); err != nil {
+				return err
+			}
+			params.GenesisTrustedRoot = GenesisTrustedRoot{
+				RevisionNumber: strconv.FormatUint(revision, 10),
+				RevisionHeight: strconv.FormatInt(height, 10),
+				Hash:           base64.StdEncoding.EncodeToString(appHash),
+			}
+			bundle := LaunchBundle{
+				Params: params,
+				Mints: []LaunchBundleMint{{
+					MerkleProof:               proofJSON.Bytes(),
+					ProofHeightRevisionNumber: revision,
+					ProofHeightRevisionHeight: uint64(height),
+					KeyPath:                   []string{mintburntypes.StoreKey, hex.EncodeToString(key)},
+					Value:                     base64.StdEncoding.EncodeToString(res.Value),
+					ProviderChainID:           params.ProviderChainID,
+					AmountDenom:               proven.Amount.Denom,
+					AmountValue:               proven.Amount.Amount.String(),
+					EscrowID:                  proven.EscrowId,
+					Recipient:                 recipient,
+				}},
+				ClaimedEscrowIDs: []string{},
+			}
+
+			bz, err := json.MarshalIndent(bundle, "", "  ")
+			if err != nil {
+				return err
+			}
+			if output == "" {
+				return clientCtx.PrintRaw(bz)
+			}
+			if err := os.WriteFile(output, append(bz, '\n'), 0o600); err != nil {
+				return err
+			}
+			cmd.Printf("wrote the bundle of escrow %s proven at height %d to %s\n", proven.EscrowId, height, output)
+			return nil
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(flagProviderClientID, "", "Client of this chain on the consumer chain")
+	cmd.Flags().String(flagMintDenom, "", "Denom minted on the consumer chain, defaults to the escrow denom")
+	cmd.Flags().String(flagBundleRecipient, "", "Recipient of the minted funds on the consumer chain")
+	cmd.Flags().String(flagConsumerAccountPrefix, "", "Bech32 account prefix of the consumer chain, to mint to the escrow recipient when --recipient is unset")
+	cmd.Flags().String(flagICAControllerConnectionID, "", "Connection the consumer chain controls its ICA on this chain through")
+	cmd.Flags().String(flagICAOwner, "", "Owner of the consumer chain ICA")
+	cmd.Flags().Uint64(flagICATxTimeoutSeconds, 600, "Timeout of the consumer chain ICA txs")
+	cmd.Flags().Uint64(flagICAMaxClaimPerBlock, 10, "Escrows the consumer chain marks claimed per block at most")
+	cmd.Flags().String(flagBundleOutput, "", "File to write the bundle to instead of stdout")
+	cmd.Flags().Duration(flagBundleWait, time.Minute, "How long to wait for the escrow and the next block")
+	_ = cmd.MarkFlagRequired(flagProviderClientID)
+
+	return cmd
+}
+
+// VerifyEscrowProof verifies that value is committed under the escrow key in
+// the mintburn store of the state whose app hash is appHash, and returns the
+// decoded escrow.
+func VerifyEscrowProof(cdc codec.BinaryCodec, proof commitmenttypes.MerkleProof, appHash, key, value []byte) (mintburntypes.Escrow, error) {
+	path := commitmenttypes.NewMerklePath(mintburntypes.StoreKey, string(key))
+	if err := proof.VerifyMembership(commitmenttypes.GetSDKSpecs(), commitmenttypes.NewMerkleRoot(appHash), path, value); err != nil {
+		return mintburntypes.Escrow{}, fmt.Errorf("escrow proof does not verify against app hash %X: %w", appHash, err)
+	}
+	var escrow mintburntypes.Escrow
+	if err := cdc.Unmarshal(value, &escrow); err != nil {
+		return mintburntypes.Escrow{}, fmt.Errorf("failed to decode the proven escrow: %w", err)
+	}
+	return escrow, nil
+}
+
+func waitForPendingEscrow(ctx context.Context, clientCtx client.Context, consumerChainID, denom string) (mintburntypes.Escrow, error) {
+	queryClient := mintburntypes.NewQueryClient(clientCtx)
+	for {
+		res, err := queryClient.Escrow(ctx, &mintburntypes.QueryEscrowRequest{ConsumerChainId: consumerChainID, Denom: denom})
+		if err == nil && res.Escrow != nil && res.Escrow.Status == mintburntypes.EscrowStatus_ESCROW_STATUS_PENDING {
+			return *res.Escrow, nil
+		}
+		select {
+		case <-ctx.Done():
+			return mintburntypes.Escrow{}, fmt.Errorf("no pending %s escrow of %s: %w", denom, consumerChainID, ctx.Err())
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+func waitForAppHash(ctx context.Context, clientCtx client.Context, height int64) ([]byte, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		block, err := node.Block(ctx, &height)
+		if err == nil && block.Block != nil {
+			return block.Block.AppHash, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("block %d is not available: %w", height, ctx.Err())
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// bundleRecipient returns recipient, or else the escrow recipient, which has
+// this chain's prefix, re-encoded with the consumer account prefix.
+func bundleRecipient(recipient string, escrow mintburntypes.Escrow, consumerPrefix string) (string, error) {
+	if recipient != "" {
+		return recipient, nil
+	}
+	if escrow.Recipient == "" {
+		return "", fmt.Errorf("escrow %s has no recipient, set --%s", escrow.EscrowId, flagBundleRecipient)
+	}
+	return addressutil.ConvertBech32Prefix(escrow.Recipient, consumerPrefix)
+}
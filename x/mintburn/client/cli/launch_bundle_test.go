@@ -0,0 +1,84 @@
+package cli_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+
+	"github.com/maany-xyz/maany-provider/app/params"
+	"github.com/maany-xyz/maany-provider/x/mintburn/client/cli"
+	mintburntypes "github.com/maany-xyz/maany-provider/x/mintburn/types"
+)
+
+func TestVerifyEscrowProof(t *testing.T) {
+	cdc := params.MakeEncodingConfig().Marshaler
+
+	storeKey := storetypes.NewKVStoreKey(mintburntypes.StoreKey)
+	otherKey := storetypes.NewKVStoreKey("bank")
+	ms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	ms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(otherKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	escrow := mintburntypes.Escrow{
+		EscrowId:        "1",
+		ConsumerChainId: "maanydex",
+		Amount:          sdk.NewCoin("umaany", math.NewInt(1000)),
+		Status:          mintburntypes.EscrowStatus_ESCROW_STATUS_PENDING,
+	}
+	key := mintburntypes.EscrowKeyByID(escrow.EscrowId)
+	value := cdc.MustMarshal(&escrow)
+	ms.GetKVStore(storeKey).Set(key, value)
+	ms.GetKVStore(otherKey).Set([]byte("balance"), []byte("1"))
+	commit := ms.Commit()
+
+	res, err := ms.Query(&storetypes.RequestQuery{
+		Path:   fmt.Sprintf("/%s/key", mintburntypes.StoreKey),
+		Data:   key,
+		Height: commit.Version,
+		Prove:  true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, value, res.Value)
+	proof, err := commitmenttypes.ConvertProofs(res.ProofOps)
+	require.NoError(t, err)
+
+	proven, err := cli.VerifyEscrowProof(cdc, proof, commit.Hash, key, res.Value)
+	require.NoError(t, err)
+	require.Equal(t, escrow.EscrowId, proven.EscrowId)
+	require.Equal(t, escrow.Amount, proven.Amount)
+
+	// wrong app hash
+	_, err = cli.VerifyEscrowProof(cdc, proof, []byte("not the app hash"), key, res.Value)
+	require.Error(t, err)
+
+	// tampered value
+	tampered := escrow
+	tampered.Amount = sdk.NewCoin("umaany", math.NewInt(1_000_000))
+	_, err = cli.VerifyEscrowProof(cdc, proof, commit.Hash, key, cdc.MustMarshal(&tampered))
+	require.Error(t, err)
+}
+
+func TestLaunchBundleRequiresConsumerRecipient(t *testing.T) {
+	// the escrow recipient has this chain's prefix, it can't be used as is
+	cmd := cli.NewLaunchBundleCmd()
+	cmd.SetContext(context.WithValue(context.Background(), client.ClientContextKey, &client.Context{}))
+	cmd.SetArgs([]string{"maanydex", "umaany", "--provider-client-id", "07-tendermint-0"})
+	err := cmd.Execute()
+	require.ErrorContains(t, err, "--recipient")
+	require.ErrorContains(t, err, "--consumer-account-prefix")
+}
@@ -22,6 +22,7 @@ func NewTxCmd() *cobra.Command {
     NewEscrowInitialCmd(),
     NewCancelEscrowCmd(),
     NewMarkEscrowClaimedCmd(),
+    NewLaunchBundleCmd(),
   )
   return cmd
 }
@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	mintburntypes "github.com/maany-xyz/maany-provider/x/mintburn/types"
+)
+
+// InitGenesis stores the params, escrows, allowed channels and authorized
+// ICAs. The mintburn module account must hold the pending escrow amounts.
+func (k Keeper) InitGenesis(ctx sdk.Context, gs mintburntypes.GenesisState) {
+	if err := k.SetParams(ctx, gs.Params); err != nil {
+		panic(err)
+	}
+
+	// the module account holds the escrowed funds
+	k.accountKeeper.GetModuleAccount(ctx, mintburntypes.ModuleName)
+	pending := gs.PendingEscrowAmount()
+	balance := k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(mintburntypes.ModuleName))
+	if !balance.IsAllGTE(pending) {
+		panic(fmt.Sprintf("mintburn module account balance %s does not cover the pending escrows %s", balance, pending))
+	}
+
+	for _, e := range gs.Escrows {
+		k.SetEscrow(ctx, e)
+	}
+	k.setEscrowIDCounter(ctx, gs.EscrowIdCounter)
+	for _, channelID := range gs.AllowedChannels {
+		k.SetAllowedChannel(ctx, channelID)
+	}
+	for _, ica := range gs.AuthorizedIcas {
+		k.SetAuthorizedICA(ctx, ica.ConsumerChainId, ica.Address)
+	}
+}
+
+// ExportGenesis returns the params, escrows, allowed channels and authorized
+// ICAs.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *mintburntypes.GenesisState {
+	gs := &mintburntypes.GenesisState{
+		Params:          k.GetParams(ctx),
+		EscrowIdCounter: k.getEscrowIDCounter(ctx),
+		AllowedChannels: k.GetAllowedChannels(ctx),
+	}
+	k.IterateEscrows(ctx, func(e mintburntypes.Escrow) bool {
+		gs.Escrows = append(gs.Escrows, e)
+		return false
+	})
+	k.IterateAuthorizedICAs(ctx, func(ica mintburntypes.AuthorizedICA) bool {
+		gs.AuthorizedIcas = append(gs.AuthorizedIcas, ica)
+		return false
+	})
+	return gs
+}
+
+// SetAllowedChannel allows the transfer channel to release escrowed funds.
+func (k Keeper) SetAllowedChannel(ctx sdk.Context, channelID string) {
+	ps := prefix.NewStore(ctx.KVStore(k.StoreKey), []byte("allowed-channel/"))
+	ps.Set([]byte(channelID), []byte{1})
+}
+
+// DeleteAllowedChannel disallows the transfer channel.
+func (k Keeper) DeleteAllowedChannel(ctx sdk.Context, channelID string) {
+	ps := prefix.NewStore(ctx.KVStore(k.StoreKey), []byte("allowed-channel/"))
+	ps.Delete([]byte(channelID))
+}
+
+// GetAllowedChannels returns the IDs of the allowed transfer channels.
+func (k Keeper) GetAllowedChannels(ctx sdk.Context) []string {
+	ps := prefix.NewStore(ctx.KVStore(k.StoreKey), []byte("allowed-channel/"))
+	it := ps.Iterator(nil, nil)
+	defer it.Close()
+
+	var channels []string
+	for ; it.Valid(); it.Next() {
+		channels = append(channels, string(it.Key()))
+	}
+	return channels
+}
+
+// IterateAuthorizedICAs iterates the authorized ICA of every consumer chain.
+func (k Keeper) IterateAuthorizedICAs(ctx sdk.Context, cb func(ica mintburntypes.AuthorizedICA) (stop bool)) {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.StoreKey), mintburntypes.AuthorizedICAPrefix)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		ica := mintburntypes.AuthorizedICA{
+			ConsumerChainId: string(it.Key()[len(mintburntypes.AuthorizedICAPrefix):]),
+			Address:         string(it.Value()),
+		}
+		if cb(ica) {
+			return
+		}
+	}
+}
+
+// DeleteAuthorizedICA removes the authorized ICA of the consumer chain.
+func (k Keeper) DeleteAuthorizedICA(ctx sdk.Context, consumerChainID string) {
+	ctx.KVStore(k.StoreKey).Delete(mintburntypes.AuthorizedICAKey(consumerChainID))
+}
+
+func (k Keeper) getEscrowIDCounter(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.StoreKey).Get(mintburntypes.EscrowIDCounterKey)
+	if len(bz) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setEscrowIDCounter(ctx sdk.Context, n uint64) {
+	ctx.KVStore(k.StoreKey).Set(mintburntypes.EscrowIDCounterKey, sdk.Uint64ToBigEndian(n))
+}
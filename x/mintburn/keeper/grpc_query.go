@@ -78,3 +78,8 @@ func (q queryServer) AuthorizedICA(ctx context.Context, req *types.QueryAuthoriz
     }
     return &types.QueryAuthorizedICAResponse{IcaAddress: addr, Found: true}, nil
 }
+
+// Params returns the escrow policy parameters
+func (q queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	return &types.QueryParamsResponse{Params: q.GetParams(sdk.UnwrapSDKContext(ctx))}, nil
+}
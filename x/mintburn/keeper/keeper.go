@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
@@ -11,6 +12,7 @@ import (
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 
+	gaiaerrors "github.com/maany-xyz/maany-provider/types/errors"
 	mintburntypes "github.com/maany-xyz/maany-provider/x/mintburn/types"
 )
 
@@ -37,6 +39,10 @@ type Keeper struct {
 	ChannelKeeper    ChannelKeeper
 	ConnectionKeeper ConnectionKeeper
 	ClientKeeper     ClientKeeper
+	circuitBreaker   mintburntypes.CircuitBreakerKeeper
+
+	// the address capable of executing a MsgUpdateParams message, typically x/gov
+	authority string
 }
 
 func NewKeeper(
@@ -48,6 +54,8 @@ func NewKeeper(
 	channelKeeper ChannelKeeper,
 	connectionKeeper ConnectionKeeper,
 	clientKeeper ClientKeeper,
+	circuitBreaker mintburntypes.CircuitBreakerKeeper,
+	authority string,
 ) Keeper {
 	return Keeper{
 		cdc:              cdc,
@@ -58,6 +66,8 @@ func NewKeeper(
 		ChannelKeeper:    channelKeeper,
 		ConnectionKeeper: connectionKeeper,
 		ClientKeeper:     clientKeeper,
+		circuitBreaker:   circuitBreaker,
+		authority:        authority,
 	}
 }
 
@@ -71,6 +81,22 @@ func (k Keeper) SendFromEscrowToAccount(ctx sdk.Context, escrow sdk.AccAddress,
 	return k.bankKeeper.SendCoins(ctx, escrow, to, sdk.NewCoins(coin))
 }
 
+// IsChannelPaused returns whether inbound packets on the channel must be
+// rejected by the circuit breaker.
+func (k Keeper) IsChannelPaused(ctx sdk.Context, channelID string) bool {
+	return k.circuitBreaker.IsChannelPaused(ctx, channelID)
+}
+
+// checkNotPaused returns an error if the msg type is paused by the circuit
+// breaker. The ante handler rejects paused msgs too, this also covers the msgs
+// executed through authz or by an interchain account.
+func (k Keeper) checkNotPaused(ctx sdk.Context, msg sdk.Msg) error {
+	if msgType := sdk.MsgTypeURL(msg); k.circuitBreaker.IsMsgTypePaused(ctx, msgType) {
+		return errorsmod.Wrapf(gaiaerrors.ErrPaused, "msg type %s", msgType)
+	}
+	return nil
+}
+
 func (k Keeper) IsAllowedChannel(ctx sdk.Context, channelID string) bool {
     ps := prefix.NewStore(ctx.KVStore(k.StoreKey), []byte("allowed-channel/"))
     return ps.Has([]byte(channelID))
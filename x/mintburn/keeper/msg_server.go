@@ -4,8 +4,11 @@ import (
 	"context"
 	"encoding/binary"
 
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/maany-xyz/maany-provider/x/mintburn/types"
 )
@@ -31,6 +34,9 @@ func (k Keeper) NextEscrowID(ctx sdk.Context) string {
 
 func (k Keeper) EscrowInitial(goCtx context.Context, msg *types.MsgEscrowInitial) (*types.MsgEscrowInitialResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkNotPaused(ctx, msg); err != nil {
+		return nil, err
+	}
 
 	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
 		return nil, sdkerrors.ErrInvalidCoins
@@ -63,6 +69,9 @@ func (k Keeper) EscrowInitial(goCtx context.Context, msg *types.MsgEscrowInitial
 
 func (k Keeper) CancelEscrow(goCtx context.Context, msg *types.MsgCancelEscrow) (*types.MsgCancelEscrowResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkNotPaused(ctx, msg); err != nil {
+		return nil, err
+	}
 
 	esc, ok := k.GetEscrow(ctx, msg.ConsumerChainId, msg.Denom)
 	if !ok {
@@ -91,6 +100,9 @@ func (k Keeper) CancelEscrow(goCtx context.Context, msg *types.MsgCancelEscrow)
 // MarkEscrowClaimed sets the escrow status to CLAIMED by escrow_id
 func (k Keeper) MarkEscrowClaimed(goCtx context.Context, msg *types.MsgMarkEscrowClaimed) (*types.MsgMarkEscrowClaimedResponse, error) {
     ctx := sdk.UnwrapSDKContext(goCtx)
+    if err := k.checkNotPaused(ctx, msg); err != nil {
+        return nil, err
+    }
 
     // Load escrow by ID
     esc, ok := k.GetEscrowByID(ctx, msg.EscrowId)
@@ -139,3 +151,19 @@ func (k Keeper) MarkEscrowClaimed(goCtx context.Context, msg *types.MsgMarkEscro
 
     return &types.MsgMarkEscrowClaimedResponse{}, nil
 }
+
+// UpdateParams replaces the escrow policy.
+func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if msg.Authority != k.authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+	if err := msg.Params.Validate(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateParamsResponse{}, nil
+}
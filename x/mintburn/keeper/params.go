@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	mintburntypes "github.com/maany-xyz/maany-provider/x/mintburn/types"
+)
+
+// GetAuthority returns the module authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the escrow policy, or the defaults if none is set.
+func (k Keeper) GetParams(ctx sdk.Context) mintburntypes.Params {
+	bz := ctx.KVStore(k.StoreKey).Get(mintburntypes.ParamsKey)
+	if bz == nil {
+		return mintburntypes.DefaultParams()
+	}
+	var params mintburntypes.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+func (k Keeper) SetParams(ctx sdk.Context, params mintburntypes.Params) error {
+	if err := params.Validate(); err != nil {
+		return fmt.Errorf("invalid params: %w", err)
+	}
+	ctx.KVStore(k.StoreKey).Set(mintburntypes.ParamsKey, k.cdc.MustMarshal(&params))
+	return nil
+}
+
+// IncrementEscrowCount records an escrow created by sender and returns the
+// number of escrows it created in the current window of window blocks,
+// including this one.
+func (k Keeper) IncrementEscrowCount(ctx sdk.Context, sender sdk.AccAddress, window uint64) uint32 {
+	store := ctx.KVStore(k.StoreKey)
+	key := mintburntypes.EscrowRateLimitKey(sender)
+	height := uint64(ctx.BlockHeight())
+
+	start, count := height, uint32(0)
+	if bz := store.Get(key); len(bz) == 12 {
+		start, count = binary.BigEndian.Uint64(bz[:8]), binary.BigEndian.Uint32(bz[8:])
+		if height >= start+window {
+			start, count = height, 0
+		}
+	}
+	count++
+
+	out := make([]byte, 12)
+	binary.BigEndian.PutUint64(out[:8], start)
+	binary.BigEndian.PutUint32(out[8:], count)
+	store.Set(key, out)
+	return count
+}
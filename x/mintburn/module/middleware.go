@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	ibctmtypes "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	gaiaerrors "github.com/maany-xyz/maany-provider/types/errors"
 	mintburn "github.com/maany-xyz/maany-provider/x/mintburn/keeper"
 )
 
@@ -181,13 +183,23 @@ func (im IBCMiddleware) OnRecvPacket(
 ) exported.Acknowledgement {
     okAck := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
 
+    // A channel paused by the circuit breaker rejects every packet with an
+    // error ack, so the counterparty refunds the sender.
+    if im.keeper.IsChannelPaused(ctx, packet.DestinationChannel) {
+        ctx.Logger().Info("mintburn: rejected packet on paused channel",
+            "channel", packet.DestinationChannel, "seq", packet.Sequence)
+        return channeltypes.NewErrorAcknowledgement(errorsmod.Wrapf(gaiaerrors.ErrPaused, "channel %s", packet.DestinationChannel))
+    }
+
     var data ibctransfertypes.FungibleTokenPacketData
     if err := json.Unmarshal(packet.GetData(), &data); err != nil {
         return channeltypes.NewErrorAcknowledgement(fmt.Errorf("invalid packet data"))
     }
 
-    // Only handle our allow-listed transfer channel
-    if packet.SourcePort != "transfer" || !im.keeper.IsAllowedChannel(ctx, packet.SourceChannel) {
+    // Only handle our allow-listed transfer channel. The allow-list holds our
+    // end of the channel, as HandleChannelIdStorage records it, which is the
+    // destination of a received packet.
+    if packet.DestinationPort != "transfer" || !im.keeper.IsAllowedChannel(ctx, packet.DestinationChannel) {
         return im.app.OnRecvPacket(ctx, packet, relayer)
     }
 
@@ -0,0 +1,51 @@
+package mintburn_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/maany-xyz/maany-provider/app/helpers"
+	mintburnmodule "github.com/maany-xyz/maany-provider/x/mintburn/module"
+)
+
+func TestAllowedChannelKeysOnDestination(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+
+	// channel-1 is our end, the counterparty sends on channel-7
+	gaiaApp.MintBurnKeeper.SetAllowedChannel(ctx, "channel-1")
+	coins := sdk.NewCoins(sdk.NewInt64Coin("umaany", 1000))
+	escrowAddr := ibctransfertypes.GetEscrowAddress("transfer", "channel-1")
+	require.NoError(t, gaiaApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(t, gaiaApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, escrowAddr, coins))
+
+	rcpt := sdk.AccAddress("escrow_recipient____")
+	data := ibctransfertypes.NewFungibleTokenPacketData("umaany", "1000", sdk.AccAddress("dex_sender__________").String(), rcpt.String(), "")
+	packet := channeltypes.Packet{
+		SourcePort:         "transfer",
+		SourceChannel:      "channel-7",
+		DestinationPort:    "transfer",
+		DestinationChannel: "channel-1",
+		Sequence:           1,
+		Data:               data.GetBytes(),
+	}
+	middleware := mintburnmodule.NewIBCMiddleware(nil, gaiaApp.MintBurnKeeper)
+
+	// pausing our end of the channel rejects the packet
+	gaiaApp.CircuitBreakerKeeper.SetChannelPaused(ctx, "channel-1", true)
+	require.False(t, middleware.OnRecvPacket(ctx, packet, sdk.AccAddress("relayer_____________")).Success())
+	require.True(t, gaiaApp.BankKeeper.GetAllBalances(ctx, rcpt).IsZero())
+
+	// once resumed the allow-listed channel releases from escrow
+	gaiaApp.CircuitBreakerKeeper.SetChannelPaused(ctx, "channel-1", false)
+	require.True(t, middleware.OnRecvPacket(ctx, packet, sdk.AccAddress("relayer_____________")).Success())
+	require.Equal(t, coins, gaiaApp.BankKeeper.GetAllBalances(ctx, rcpt))
+}
@@ -2,6 +2,7 @@ package mintburn
 
 import (
 	"encoding/json"
+	"fmt"
 
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	"cosmossdk.io/log"
@@ -46,12 +47,16 @@ func (AppModuleBasic) RegisterInterfaces(reg cdctypes.InterfaceRegistry) {
 	mintburntypes.RegisterInterfaces(reg)
 }
 
-func (AppModuleBasic) DefaultGenesis(codec.JSONCodec) json.RawMessage {
-	return []byte(`{}`)
+func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(mintburntypes.DefaultGenesisState())
 }
 
-func (AppModuleBasic) ValidateGenesis(codec.JSONCodec, client.TxEncodingConfig, json.RawMessage) error {
-	return nil
+func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
+	var gs mintburntypes.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &gs); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", mintburntypes.ModuleName, err)
+	}
+	return gs.Validate()
 }
 
 func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
@@ -103,6 +108,11 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
             {ProtoField: "consumer_chain_id"},
           },
         },
+        {
+          RpcMethod: "Params",
+          Use:       "params",
+          Short:     "Show the escrow policy parameters",
+        },
       },
     },
   }
@@ -131,12 +141,15 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
     mintburntypes.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServer(am.keeper))
 }
 
-func (am AppModule) InitGenesis(sdk.Context, codec.JSONCodec, json.RawMessage) []abci.ValidatorUpdate {
+func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, bz json.RawMessage) []abci.ValidatorUpdate {
+	var gs mintburntypes.GenesisState
+	cdc.MustUnmarshalJSON(bz, &gs)
+	am.keeper.InitGenesis(ctx, gs)
 	return nil
 }
 
-func (am AppModule) ExportGenesis(sdk.Context, codec.JSONCodec) json.RawMessage {
-	return []byte(`{}`)
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(am.keeper.ExportGenesis(ctx))
 }
 
 func (am AppModule) ConsensusVersion() uint64 { return 1 }
@@ -10,5 +10,6 @@ func RegisterInterfaces(reg codectypes.InterfaceRegistry) {
         &MsgEscrowInitial{},
         &MsgCancelEscrow{},
         &MsgMarkEscrowClaimed{},
+        &MsgUpdateParams{},
     )
 }
@@ -23,4 +23,11 @@ type BankKeeper interface {
 
 type AccountKeeper interface {
 	GetModuleAddress(name string) sdk.AccAddress
-}
\ No newline at end of file
+	GetModuleAccount(ctx context.Context, name string) sdk.ModuleAccountI
+}
+
+// CircuitBreakerKeeper reports the msg types and channels paused in x/circuitbreaker.
+type CircuitBreakerKeeper interface {
+	IsMsgTypePaused(ctx sdk.Context, msgType string) bool
+	IsChannelPaused(ctx sdk.Context, channelID string) bool
+}
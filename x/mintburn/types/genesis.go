@@ -0,0 +1,96 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// DefaultGenesisState returns the default mintburn genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate checks the validity of the GenesisState.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(gs.Escrows))
+	for _, e := range gs.Escrows {
+		if err := ValidateEscrow(e); err != nil {
+			return fmt.Errorf("escrow %s: %w", e.EscrowId, err)
+		}
+		id, err := strconv.ParseUint(e.EscrowId, 10, 64)
+		if err != nil || id == 0 || id > gs.EscrowIdCounter {
+			return fmt.Errorf("escrow id %s must be a number within [1, %d]", e.EscrowId, gs.EscrowIdCounter)
+		}
+		if seen[e.EscrowId] {
+			return fmt.Errorf("duplicate escrow id %s", e.EscrowId)
+		}
+		seen[e.EscrowId] = true
+	}
+
+	seen = make(map[string]bool, len(gs.AllowedChannels))
+	for _, channelID := range gs.AllowedChannels {
+		if err := host.ChannelIdentifierValidator(channelID); err != nil {
+			return fmt.Errorf("invalid allowed channel %s: %w", channelID, err)
+		}
+		if seen[channelID] {
+			return fmt.Errorf("duplicate allowed channel %s", channelID)
+		}
+		seen[channelID] = true
+	}
+
+	seen = make(map[string]bool, len(gs.AuthorizedIcas))
+	for _, ica := range gs.AuthorizedIcas {
+		if strings.TrimSpace(ica.ConsumerChainId) == "" {
+			return fmt.Errorf("authorized ICA %s has no consumer chain id", ica.Address)
+		}
+		if _, err := sdk.AccAddressFromBech32(ica.Address); err != nil {
+			return fmt.Errorf("invalid authorized ICA of %s: %w", ica.ConsumerChainId, err)
+		}
+		if seen[ica.ConsumerChainId] {
+			return fmt.Errorf("duplicate authorized ICA for %s", ica.ConsumerChainId)
+		}
+		seen[ica.ConsumerChainId] = true
+	}
+	return nil
+}
+
+// PendingEscrowAmount returns the coins the mintburn module account must hold
+// to back the pending escrows.
+func (gs GenesisState) PendingEscrowAmount() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, e := range gs.Escrows {
+		if e.Status == EscrowStatus_ESCROW_STATUS_PENDING {
+			total = total.Add(e.Amount)
+		}
+	}
+	return total
+}
+
+// ValidateEscrow checks the fields of an escrow.
+func ValidateEscrow(e Escrow) error {
+	if strings.TrimSpace(e.ConsumerChainId) == "" {
+		return fmt.Errorf("consumer chain id is required")
+	}
+	if !e.Amount.IsValid() || !e.Amount.Amount.IsPositive() {
+		return fmt.Errorf("invalid amount %s", e.Amount)
+	}
+	if e.Recipient != "" {
+		if _, err := sdk.AccAddressFromBech32(e.Recipient); err != nil {
+			return fmt.Errorf("invalid recipient: %w", err)
+		}
+	}
+	if _, ok := EscrowStatus_name[int32(e.Status)]; !ok || e.Status == EscrowStatus_ESCROW_STATUS_UNSPECIFIED {
+		return fmt.Errorf("invalid status %s", e.Status)
+	}
+	return nil
+}
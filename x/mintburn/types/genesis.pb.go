@@ -0,0 +1,1046 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: maany/mintburn/v1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the escrow policy applied by the mintburn ante decorator.
+// The zero value disables every check.
+type Params struct {
+	// min_escrow_amount is the smallest escrow accepted per denom. Denoms that
+	// are not listed have no minimum.
+	MinEscrowAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=min_escrow_amount,json=minEscrowAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_escrow_amount"`
+	// escrow_rate_limit_window is the length in blocks of the window in which a
+	// sender may create at most max_escrows_per_window escrows. 0 disables the
+	// rate limit.
+	EscrowRateLimitWindow uint64 `protobuf:"varint,2,opt,name=escrow_rate_limit_window,json=escrowRateLimitWindow,proto3" json:"escrow_rate_limit_window,omitempty"`
+	MaxEscrowsPerWindow   uint32 `protobuf:"varint,3,opt,name=max_escrows_per_window,json=maxEscrowsPerWindow,proto3" json:"max_escrows_per_window,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b89ae4fbab3b425, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMinEscrowAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinEscrowAmount
+	}
+	return nil
+}
+
+func (m *Params) GetEscrowRateLimitWindow() uint64 {
+	if m != nil {
+		return m.EscrowRateLimitWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxEscrowsPerWindow() uint32 {
+	if m != nil {
+		return m.MaxEscrowsPerWindow
+	}
+	return 0
+}
+
+// AuthorizedICA is the interchain account of a consumer chain allowed to mark
+// its escrows claimed.
+type AuthorizedICA struct {
+	ConsumerChainId string `protobuf:"bytes,1,opt,name=consumer_chain_id,json=consumerChainId,proto3" json:"consumer_chain_id,omitempty"`
+	Address         string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *AuthorizedICA) Reset()         { *m = AuthorizedICA{} }
+func (m *AuthorizedICA) String() string { return proto.CompactTextString(m) }
+func (*AuthorizedICA) ProtoMessage()    {}
+func (*AuthorizedICA) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b89ae4fbab3b425, []int{1}
+}
+func (m *AuthorizedICA) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthorizedICA) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthorizedICA.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthorizedICA) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthorizedICA.Merge(m, src)
+}
+func (m *AuthorizedICA) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthorizedICA) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthorizedICA.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthorizedICA proto.InternalMessageInfo
+
+func (m *AuthorizedICA) GetConsumerChainId() string {
+	if m != nil {
+		return m.ConsumerChainId
+	}
+	return ""
+}
+
+func (m *AuthorizedICA) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// GenesisState defines the mintburn genesis state.
+type GenesisState struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// escrows are the escrows held by the mintburn module account. The pending
+	// ones must be backed by its balance.
+	Escrows []Escrow `protobuf:"bytes,2,rep,name=escrows,proto3" json:"escrows"`
+	// escrow_id_counter is the id of the last escrow created, new escrows
+	// continue from it.
+	EscrowIdCounter uint64 `protobuf:"varint,3,opt,name=escrow_id_counter,json=escrowIdCounter,proto3" json:"escrow_id_counter,omitempty"`
+	// allowed_channels are the IDs, on this chain, of the transfer channels to
+	// the DEX chain. A received packet is matched on its destination channel.
+	AllowedChannels []string        `protobuf:"bytes,4,rep,name=allowed_channels,json=allowedChannels,proto3" json:"allowed_channels,omitempty"`
+	AuthorizedIcas  []AuthorizedICA `protobuf:"bytes,5,rep,name=authorized_icas,json=authorizedIcas,proto3" json:"authorized_icas"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b89ae4fbab3b425, []int{2}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetEscrows() []Escrow {
+	if m != nil {
+		return m.Escrows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEscrowIdCounter() uint64 {
+	if m != nil {
+		return m.EscrowIdCounter
+	}
+	return 0
+}
+
+func (m *GenesisState) GetAllowedChannels() []string {
+	if m != nil {
+		return m.AllowedChannels
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuthorizedIcas() []AuthorizedICA {
+	if m != nil {
+		return m.AuthorizedIcas
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "maany.mintburn.v1.Params")
+	proto.RegisterType((*AuthorizedICA)(nil), "maany.mintburn.v1.AuthorizedICA")
+	proto.RegisterType((*GenesisState)(nil), "maany.mintburn.v1.GenesisState")
+}
+
+func init() { proto.RegisterFile("maany/mintburn/v1/genesis.proto", fileDescriptor_6b89ae4fbab3b425) }
+
+var fileDescriptor_6b89ae4fbab3b425 = []byte{
+	// 560 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0xcd, 0x6e, 0x13, 0x3d,
+	0x14, 0xcd, 0x24, 0xd3, 0xb4, 0xf5, 0xf7, 0x95, 0x34, 0x53, 0x40, 0x43, 0x16, 0x93, 0x51, 0x56,
+	0x01, 0xa9, 0x33, 0x84, 0x22, 0x55, 0x2c, 0x93, 0x08, 0xa1, 0x54, 0x08, 0xaa, 0x41, 0x08, 0x89,
+	0xcd, 0xc8, 0x19, 0x9b, 0xc4, 0x22, 0xb6, 0x23, 0xdb, 0xf9, 0xeb, 0x53, 0xf0, 0x1c, 0x15, 0x0f,
+	0xd2, 0x65, 0x97, 0xac, 0x00, 0x25, 0x2f, 0x82, 0xfc, 0x13, 0x0a, 0x6a, 0x59, 0x8d, 0xe7, 0x9e,
+	0x7b, 0x7c, 0xee, 0xbd, 0xe7, 0x1a, 0x34, 0x29, 0x84, 0x6c, 0x95, 0x52, 0xc2, 0xd4, 0x70, 0x26,
+	0x58, 0x3a, 0xef, 0xa4, 0x23, 0xcc, 0xb0, 0x24, 0x32, 0x99, 0x0a, 0xae, 0x78, 0x50, 0x37, 0x09,
+	0xc9, 0x36, 0x21, 0x99, 0x77, 0x1a, 0x51, 0xc1, 0x25, 0xe5, 0x32, 0x1d, 0x42, 0x89, 0xd3, 0x79,
+	0x67, 0x88, 0x15, 0xec, 0xa4, 0x05, 0x27, 0xcc, 0x52, 0x1a, 0xf7, 0x47, 0x7c, 0xc4, 0xcd, 0x31,
+	0xd5, 0x27, 0x17, 0x8d, 0x6e, 0x2b, 0x61, 0x59, 0x08, 0xbe, 0xb0, 0x78, 0xeb, 0x6b, 0x19, 0x54,
+	0xcf, 0xa1, 0x80, 0x54, 0x06, 0x0b, 0x50, 0xa7, 0x84, 0xe5, 0x16, 0xce, 0x21, 0xe5, 0x33, 0xa6,
+	0x42, 0x2f, 0xae, 0xb4, 0xff, 0x7b, 0xf6, 0x28, 0xb1, 0xe2, 0x89, 0x16, 0x4f, 0x9c, 0x78, 0xd2,
+	0xe7, 0x84, 0xf5, 0x9e, 0x5e, 0x7d, 0x6f, 0x96, 0x2e, 0x7f, 0x34, 0xdb, 0x23, 0xa2, 0xc6, 0xb3,
+	0x61, 0x52, 0x70, 0x9a, 0xba, 0x4a, 0xed, 0xe7, 0x58, 0xa2, 0xcf, 0xa9, 0x5a, 0x4d, 0xb1, 0x34,
+	0x04, 0x99, 0xd5, 0x28, 0x61, 0x2f, 0x8d, 0x48, 0xd7, 0x68, 0x04, 0xa7, 0x20, 0x74, 0xa2, 0x02,
+	0x2a, 0x9c, 0x4f, 0x08, 0x25, 0x2a, 0x5f, 0x10, 0x86, 0xf8, 0x22, 0x2c, 0xc7, 0x5e, 0xdb, 0xcf,
+	0x1e, 0x58, 0x3c, 0x83, 0x0a, 0xbf, 0xd6, 0xe8, 0x07, 0x03, 0x06, 0x27, 0xe0, 0x21, 0x85, 0x4b,
+	0x57, 0xb1, 0xcc, 0xa7, 0x58, 0x6c, 0x69, 0x95, 0xd8, 0x6b, 0x1f, 0x64, 0x47, 0x14, 0x2e, 0xad,
+	0x92, 0x3c, 0xc7, 0xc2, 0x92, 0xce, 0xfc, 0x3d, 0xff, 0x70, 0xe7, 0xcc, 0xdf, 0xdb, 0x39, 0xac,
+	0x66, 0x47, 0xc5, 0x04, 0x12, 0x9a, 0x7f, 0xc2, 0x38, 0x87, 0x93, 0x09, 0x5f, 0x40, 0x56, 0xe0,
+	0xac, 0x71, 0x47, 0x50, 0xdf, 0x4d, 0x38, 0x6a, 0xbd, 0x07, 0x07, 0xdd, 0x99, 0x1a, 0x73, 0x41,
+	0x2e, 0x30, 0x1a, 0xf4, 0xbb, 0xc1, 0x13, 0x50, 0x2f, 0x38, 0x93, 0x33, 0x8a, 0x45, 0x5e, 0x8c,
+	0x21, 0x61, 0x39, 0x41, 0xa1, 0x17, 0x7b, 0xed, 0xfd, 0xac, 0xb6, 0x05, 0xfa, 0x3a, 0x3e, 0x40,
+	0x41, 0x08, 0x76, 0x21, 0x42, 0x02, 0x4b, 0x69, 0xda, 0xda, 0xcf, 0xb6, 0xbf, 0xad, 0xcb, 0x32,
+	0xf8, 0xff, 0x95, 0x5d, 0x80, 0x77, 0x0a, 0x2a, 0x1c, 0x9c, 0x82, 0xea, 0xd4, 0xb8, 0x62, 0xee,
+	0xd2, 0x06, 0xdc, 0x5a, 0x88, 0xc4, 0xda, 0xd6, 0xf3, 0xb5, 0x01, 0x99, 0x4b, 0x0f, 0x5e, 0x80,
+	0x5d, 0x37, 0x8e, 0xb0, 0x1c, 0x57, 0xfe, 0xc1, 0xb4, 0x33, 0x71, 0xcc, 0x6d, 0xbe, 0x6e, 0xc5,
+	0xd9, 0x40, 0x50, 0x5e, 0x68, 0x67, 0xb0, 0x30, 0x83, 0xf4, 0xb3, 0x9a, 0x05, 0x06, 0xa8, 0x6f,
+	0xc3, 0xc1, 0x63, 0x70, 0x68, 0x66, 0x83, 0x91, 0xee, 0x9a, 0x31, 0x3c, 0x91, 0xa1, 0x1f, 0x57,
+	0x74, 0xd7, 0x2e, 0xde, 0x77, 0xe1, 0xe0, 0x2d, 0xa8, 0xc1, 0xdf, 0x23, 0xcb, 0x49, 0x01, 0x65,
+	0xb8, 0x63, 0x2a, 0x8b, 0xef, 0xa8, 0xec, 0xaf, 0xe1, 0xba, 0x02, 0xef, 0xdd, 0xd0, 0x07, 0x05,
+	0x94, 0xbd, 0x37, 0x57, 0xeb, 0xc8, 0xbb, 0x5e, 0x47, 0xde, 0xcf, 0x75, 0xe4, 0x7d, 0xd9, 0x44,
+	0xa5, 0xeb, 0x4d, 0x54, 0xfa, 0xb6, 0x89, 0x4a, 0x1f, 0x9f, 0xff, 0xb1, 0x83, 0xe6, 0xee, 0xe3,
+	0xe5, 0xea, 0xc2, 0x9d, 0xa6, 0x82, 0xcf, 0x09, 0xc2, 0x22, 0x5d, 0xde, 0x3c, 0x06, 0xb3, 0x95,
+	0xc3, 0xaa, 0x79, 0x09, 0x27, 0xbf, 0x06, 0x00, 0xdf, 0xb7, 0x5f, 0xb5, 0x95, 0x03, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxEscrowsPerWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxEscrowsPerWindow))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EscrowRateLimitWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EscrowRateLimitWindow))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MinEscrowAmount) > 0 {
+		for iNdEx := len(m.MinEscrowAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinEscrowAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthorizedICA) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthorizedICA) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthorizedICA) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerChainId) > 0 {
+		i -= len(m.ConsumerChainId)
+		copy(dAtA[i:], m.ConsumerChainId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConsumerChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AuthorizedIcas) > 0 {
+		for iNdEx := len(m.AuthorizedIcas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuthorizedIcas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AllowedChannels) > 0 {
+		for iNdEx := len(m.AllowedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedChannels[iNdEx])
+			copy(dAtA[i:], m.AllowedChannels[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AllowedChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.EscrowIdCounter != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EscrowIdCounter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Escrows) > 0 {
+		for iNdEx := len(m.Escrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinEscrowAmount) > 0 {
+		for _, e := range m.MinEscrowAmount {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.EscrowRateLimitWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.EscrowRateLimitWindow))
+	}
+	if m.MaxEscrowsPerWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxEscrowsPerWindow))
+	}
+	return n
+}
+
+func (m *AuthorizedICA) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Escrows) > 0 {
+		for _, e := range m.Escrows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.EscrowIdCounter != 0 {
+		n += 1 + sovGenesis(uint64(m.EscrowIdCounter))
+	}
+	if len(m.AllowedChannels) > 0 {
+		for _, s := range m.AllowedChannels {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AuthorizedIcas) > 0 {
+		for _, e := range m.AuthorizedIcas {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinEscrowAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinEscrowAmount = append(m.MinEscrowAmount, types.Coin{})
+			if err := m.MinEscrowAmount[len(m.MinEscrowAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowRateLimitWindow", wireType)
+			}
+			m.EscrowRateLimitWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EscrowRateLimitWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEscrowsPerWindow", wireType)
+			}
+			m.MaxEscrowsPerWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEscrowsPerWindow |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthorizedICA) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthorizedICA: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthorizedICA: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrows = append(m.Escrows, Escrow{})
+			if err := m.Escrows[len(m.Escrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowIdCounter", wireType)
+			}
+			m.EscrowIdCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EscrowIdCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedChannels = append(m.AllowedChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizedIcas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthorizedIcas = append(m.AuthorizedIcas, AuthorizedICA{})
+			if err := m.AuthorizedIcas[len(m.AuthorizedIcas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,6 +1,11 @@
 package types
 
-import "strconv"
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
 
 const (
 	ModuleName = "mintburn"
@@ -26,6 +31,16 @@ var EscrowIndexPrefix = []byte{0x02}
 // Authorized ICA address per consumer chain: consumer_chain_id -> bech32 addr bytes
 var AuthorizedICAPrefix = []byte{0x03}
 
+// Params of the escrow policy
+var ParamsKey = []byte{0x04}
+
+// Escrows created per sender in the current rate limit window: sender -> window start height | count
+var EscrowRateLimitPrefix = []byte{0x05}
+
+func EscrowRateLimitKey(sender sdk.AccAddress) []byte {
+	return append(EscrowRateLimitPrefix, address.MustLengthPrefix(sender)...)
+}
+
 func EscrowKeyByID(escrowID string) []byte {
 	return append(EscrowPrefix, []byte(escrowID)...)
 }
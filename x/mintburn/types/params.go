@@ -0,0 +1,29 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultParams returns the zero escrow policy: no minimum escrow and no rate
+// limit.
+func DefaultParams() Params {
+	return Params{}
+}
+
+// Validate performs validation on the escrow policy.
+func (p Params) Validate() error {
+	if err := p.MinEscrowAmount.Validate(); err != nil {
+		return fmt.Errorf("min escrow amount: %w", err)
+	}
+	if p.EscrowRateLimitWindow > 0 && p.MaxEscrowsPerWindow == 0 {
+		return fmt.Errorf("max escrows per window must be positive when the rate limit is enabled")
+	}
+	return nil
+}
+
+// MinEscrow returns the smallest escrow accepted for denom.
+func (p Params) MinEscrow(denom string) sdk.Coin {
+	return sdk.NewCoin(denom, p.MinEscrowAmount.AmountOf(denom))
+}
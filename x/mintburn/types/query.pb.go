@@ -472,6 +472,86 @@ func (m *QueryAuthorizedICAResponse) GetFound() bool {
 	return false
 }
 
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b50a42a3fb7c069a, []int{8}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b50a42a3fb7c069a, []int{9}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*QueryEscrowRequest)(nil), "maany.mintburn.v1.QueryEscrowRequest")
 	proto.RegisterType((*QueryEscrowResponse)(nil), "maany.mintburn.v1.QueryEscrowResponse")
@@ -481,58 +561,65 @@ func init() {
 	proto.RegisterType((*QueryEscrowProofResponse)(nil), "maany.mintburn.v1.QueryEscrowProofResponse")
 	proto.RegisterType((*QueryAuthorizedICARequest)(nil), "maany.mintburn.v1.QueryAuthorizedICARequest")
 	proto.RegisterType((*QueryAuthorizedICAResponse)(nil), "maany.mintburn.v1.QueryAuthorizedICAResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "maany.mintburn.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "maany.mintburn.v1.QueryParamsResponse")
 }
 
 func init() { proto.RegisterFile("maany/mintburn/v1/query.proto", fileDescriptor_b50a42a3fb7c069a) }
 
 var fileDescriptor_b50a42a3fb7c069a = []byte{
-	// 732 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x4f, 0x13, 0x4d,
-	0x18, 0x66, 0x81, 0xb6, 0x30, 0x2d, 0xf9, 0xc2, 0x7c, 0xe4, 0xfb, 0xca, 0x8a, 0x6b, 0x5d, 0xa2,
-	0x10, 0x94, 0xdd, 0x14, 0xfc, 0x15, 0x13, 0x4d, 0x10, 0x44, 0x89, 0x3f, 0x82, 0x35, 0xe1, 0xe0,
-	0x65, 0x33, 0xdd, 0x1d, 0xba, 0x13, 0xba, 0x3b, 0xcb, 0xce, 0x6c, 0xa5, 0x10, 0x3c, 0x78, 0xf0,
-	0x68, 0x4c, 0x3c, 0xf8, 0x47, 0x78, 0xf5, 0x8f, 0xf0, 0x48, 0xe2, 0xc5, 0xa3, 0x01, 0xff, 0x10,
-	0xb3, 0x33, 0x53, 0xdb, 0xa6, 0x2d, 0x10, 0xe3, 0x6d, 0xe6, 0x7d, 0x9f, 0xf7, 0x79, 0x9f, 0x79,
-	0xf6, 0x7d, 0x5b, 0x70, 0x31, 0x40, 0x28, 0x6c, 0xda, 0x01, 0x09, 0x79, 0x35, 0x89, 0x43, 0xbb,
-	0x51, 0xb6, 0x77, 0x13, 0x1c, 0x37, 0xad, 0x28, 0xa6, 0x9c, 0xc2, 0x49, 0x91, 0xb6, 0x5a, 0x69,
-	0xab, 0x51, 0xd6, 0x67, 0x6a, 0x94, 0xd6, 0xea, 0xd8, 0x46, 0x11, 0xb1, 0x51, 0x18, 0x52, 0x8e,
-	0x38, 0xa1, 0x21, 0x93, 0x05, 0xfa, 0x54, 0x8d, 0xd6, 0xa8, 0x38, 0xda, 0xe9, 0x49, 0x45, 0x8d,
-	0xde, 0x2e, 0x98, 0xb9, 0x31, 0x7d, 0xad, 0xf2, 0x73, 0xa4, 0xea, 0xda, 0x2e, 0x8d, 0xb1, 0xed,
-	0xd2, 0x20, 0x20, 0x3c, 0xc0, 0x21, 0x4f, 0x41, 0xed, 0x9b, 0x04, 0x9a, 0x5b, 0x00, 0xbe, 0x48,
-	0xe5, 0x3d, 0x14, 0xd5, 0x15, 0xbc, 0x9b, 0x60, 0xc6, 0xe1, 0x02, 0x98, 0x74, 0x69, 0xc8, 0x92,
-	0x00, 0xc7, 0x8e, 0xeb, 0x23, 0x12, 0x3a, 0xc4, 0x2b, 0x6a, 0x25, 0x6d, 0x7e, 0xbc, 0xf2, 0x4f,
-	0x2b, 0xb1, 0x9a, 0xc6, 0x37, 0x3c, 0x38, 0x05, 0x32, 0x1e, 0x0e, 0x69, 0x50, 0x1c, 0x16, 0x79,
-	0x79, 0x31, 0x1f, 0x83, 0x7f, 0xbb, 0x78, 0x59, 0x44, 0x43, 0x86, 0x61, 0x19, 0x64, 0xa5, 0x4e,
-	0xc1, 0x96, 0x5f, 0x9a, 0xb6, 0x7a, 0xfc, 0xb0, 0x54, 0x89, 0x02, 0x9a, 0x77, 0xbb, 0x98, 0x58,
-	0x4b, 0xe2, 0x2c, 0x98, 0x60, 0x1c, 0xf1, 0x84, 0x39, 0xdb, 0xa4, 0xce, 0x71, 0xac, 0xe4, 0x15,
-	0x64, 0x70, 0x5d, 0xc4, 0xcc, 0x27, 0x60, 0xaa, 0xbb, 0x56, 0xc9, 0x58, 0x06, 0x39, 0xc9, 0xce,
-	0x8a, 0x5a, 0x69, 0xe4, 0x74, 0x1d, 0x2d, 0xa4, 0xc9, 0xc0, 0xff, 0x1d, 0x64, 0x9b, 0x31, 0xa5,
-	0xdb, 0x7f, 0xcd, 0x2f, 0xf8, 0x1f, 0xc8, 0xfa, 0x98, 0xd4, 0x7c, 0x5e, 0x1c, 0x29, 0x69, 0xf3,
-	0xa3, 0x15, 0x75, 0x33, 0xdf, 0x0f, 0x83, 0x62, 0x6f, 0x57, 0xf5, 0x8c, 0x76, 0x91, 0xd6, 0x59,
-	0x94, 0xb6, 0x68, 0xa0, 0x7a, 0x82, 0x45, 0x8b, 0x42, 0x45, 0x5e, 0xe0, 0x3a, 0x28, 0x04, 0x38,
-	0xde, 0xa9, 0x63, 0x27, 0x4a, 0x59, 0x44, 0xa3, 0xfc, 0xd2, 0xac, 0x45, 0xaa, 0xae, 0x95, 0x8e,
-	0x8a, 0xd5, 0x31, 0x1c, 0x8d, 0xb2, 0xf5, 0x4c, 0x60, 0x65, 0xc3, 0x7c, 0xd0, 0xbe, 0xc0, 0x69,
-	0x30, 0xb6, 0x83, 0x9b, 0x4e, 0x84, 0xb8, 0x5f, 0x1c, 0x2d, 0x8d, 0xcc, 0x8f, 0x57, 0x72, 0x3b,
-	0xb8, 0xb9, 0x89, 0xb8, 0x0f, 0x2f, 0x80, 0x71, 0xe9, 0x56, 0xfa, 0xfe, 0x8c, 0x78, 0xdf, 0x98,
-	0x0c, 0x6c, 0x78, 0xf0, 0x32, 0x28, 0xa0, 0x80, 0x26, 0x21, 0x77, 0xe4, 0xfb, 0xb3, 0x22, 0x9f,
-	0x97, 0xb1, 0x35, 0xe1, 0x42, 0x1b, 0x22, 0xf5, 0xe7, 0x3a, 0x21, 0x5b, 0x69, 0xc8, 0x7c, 0x04,
-	0xa6, 0x85, 0x1f, 0x2b, 0x09, 0xf7, 0x69, 0x4c, 0xf6, 0xb1, 0xb7, 0xb1, 0xba, 0xf2, 0x07, 0xdf,
-	0xc1, 0x7c, 0x09, 0xf4, 0x7e, 0x44, 0xca, 0xda, 0x4b, 0x20, 0x4f, 0x5c, 0xe4, 0x20, 0xcf, 0x8b,
-	0x31, 0x63, 0x8a, 0x03, 0x10, 0x17, 0xad, 0xc8, 0x48, 0xea, 0xf1, 0x36, 0x4d, 0x42, 0x4f, 0x78,
-	0x3c, 0x56, 0x91, 0x97, 0xa5, 0x77, 0x19, 0x90, 0x11, 0xac, 0xf0, 0x93, 0x06, 0xb2, 0xf2, 0x9b,
-	0xc1, 0x2b, 0x7d, 0x86, 0xab, 0x77, 0xe9, 0xf4, 0xab, 0x67, 0xc1, 0xa4, 0x34, 0xf3, 0xfe, 0xdb,
-	0x6f, 0x3f, 0x3f, 0x0e, 0xdf, 0x81, 0xb7, 0xec, 0x41, 0x3f, 0x02, 0xcc, 0x3e, 0xe8, 0xb1, 0xe1,
-	0xd0, 0x3e, 0x10, 0xb6, 0x1f, 0xc2, 0x37, 0x20, 0xa7, 0xf6, 0x01, 0x9e, 0xd1, 0xb2, 0xb5, 0x6c,
-	0xfa, 0xdc, 0x99, 0x38, 0xa5, 0xcd, 0x14, 0xda, 0x66, 0xa0, 0x3e, 0x58, 0x1b, 0xfc, 0xa2, 0x81,
-	0x7c, 0xc7, 0x34, 0xc3, 0x85, 0xd3, 0xc9, 0x3b, 0x17, 0x4d, 0xbf, 0x76, 0x2e, 0xac, 0x12, 0xf3,
-	0x54, 0x88, 0x59, 0x87, 0x6b, 0x03, 0xc5, 0xc8, 0x4d, 0x38, 0xcd, 0x2d, 0xfb, 0x40, 0xee, 0xd4,
-	0x21, 0xfc, 0xac, 0x81, 0x89, 0xae, 0x59, 0x81, 0xd7, 0x07, 0x89, 0xe9, 0x37, 0x9b, 0xfa, 0xe2,
-	0x39, 0xd1, 0x4a, 0xfc, 0x3d, 0x21, 0xfe, 0x36, 0xbc, 0xd9, 0x47, 0x3c, 0xfa, 0x5d, 0xe1, 0x10,
-	0x17, 0xf5, 0x93, 0xff, 0xe0, 0xf9, 0xd7, 0x63, 0x43, 0x3b, 0x3a, 0x36, 0xb4, 0x1f, 0xc7, 0x86,
-	0xf6, 0xe1, 0xc4, 0x18, 0x3a, 0x3a, 0x31, 0x86, 0xbe, 0x9f, 0x18, 0x43, 0xaf, 0x6e, 0xd4, 0x08,
-	0xf7, 0x93, 0x6a, 0xba, 0xed, 0x92, 0x7a, 0x71, 0xaf, 0xb9, 0xaf, 0x4e, 0x51, 0x4c, 0x1b, 0xc4,
-	0xc3, 0xb1, 0xbd, 0xd7, 0xee, 0xc7, 0x9b, 0x11, 0x66, 0xd5, 0xac, 0xf8, 0xbb, 0x58, 0xfe, 0x15,
-	0x00, 0x00, 0xff, 0xff, 0x2f, 0x3a, 0x1d, 0xd1, 0xdf, 0x06, 0x00, 0x00,
+	// 801 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x4e, 0xeb, 0x46,
+	0x14, 0x8e, 0xf9, 0x71, 0x60, 0x12, 0x54, 0x31, 0x44, 0x6d, 0x62, 0x68, 0x08, 0x46, 0x05, 0x44,
+	0x8b, 0xad, 0x40, 0x5b, 0xaa, 0x4a, 0xad, 0xc4, 0x4f, 0x69, 0x51, 0x5b, 0x44, 0x53, 0x89, 0x45,
+	0x37, 0xd6, 0xc4, 0x1e, 0x92, 0x11, 0xb1, 0xc7, 0x78, 0xc6, 0x29, 0x01, 0xd1, 0x05, 0x0f, 0x50,
+	0x55, 0xea, 0xa2, 0x0f, 0xd1, 0x6d, 0x1f, 0x82, 0x25, 0x52, 0x37, 0x77, 0x75, 0x75, 0x05, 0xf7,
+	0x41, 0xae, 0x3c, 0x33, 0xb9, 0x49, 0x94, 0x3f, 0x74, 0x75, 0x77, 0x33, 0xe7, 0x7c, 0xe7, 0x3b,
+	0xdf, 0x9c, 0x9c, 0xcf, 0x01, 0x1f, 0xfb, 0x08, 0x05, 0x2d, 0xdb, 0x27, 0x01, 0xaf, 0xc6, 0x51,
+	0x60, 0x37, 0xcb, 0xf6, 0x65, 0x8c, 0xa3, 0x96, 0x15, 0x46, 0x94, 0x53, 0x38, 0x2f, 0xd2, 0x56,
+	0x3b, 0x6d, 0x35, 0xcb, 0xc6, 0x52, 0x8d, 0xd2, 0x5a, 0x03, 0xdb, 0x28, 0x24, 0x36, 0x0a, 0x02,
+	0xca, 0x11, 0x27, 0x34, 0x60, 0xb2, 0xc0, 0xc8, 0xd5, 0x68, 0x8d, 0x8a, 0xa3, 0x9d, 0x9c, 0x54,
+	0xb4, 0xd8, 0xdf, 0x05, 0x33, 0x37, 0xa2, 0xbf, 0xab, 0xfc, 0x72, 0x7f, 0xbe, 0x86, 0x03, 0xcc,
+	0x48, 0x9b, 0x76, 0x9d, 0x54, 0x5d, 0xdb, 0xa5, 0x11, 0xb6, 0x5d, 0xea, 0xfb, 0x84, 0xfb, 0x38,
+	0xe0, 0x09, 0xaa, 0x73, 0x93, 0x40, 0xf3, 0x0c, 0xc0, 0x5f, 0x12, 0xfd, 0xdf, 0x09, 0xfa, 0x0a,
+	0xbe, 0x8c, 0x31, 0xe3, 0x70, 0x13, 0xcc, 0xbb, 0x34, 0x60, 0xb1, 0x8f, 0x23, 0xc7, 0xad, 0x23,
+	0x12, 0x38, 0xc4, 0xcb, 0x6b, 0x25, 0x6d, 0x63, 0xb6, 0xf2, 0x41, 0x3b, 0x71, 0x90, 0xc4, 0x8f,
+	0x3d, 0x98, 0x03, 0xd3, 0x1e, 0x0e, 0xa8, 0x9f, 0x9f, 0x10, 0x79, 0x79, 0x31, 0x7f, 0x00, 0x0b,
+	0x3d, 0xbc, 0x2c, 0xa4, 0x01, 0xc3, 0xb0, 0x0c, 0x74, 0xf9, 0x10, 0xc1, 0x96, 0xd9, 0x2e, 0x58,
+	0x7d, 0x03, 0xb3, 0x54, 0x89, 0x02, 0x9a, 0x5f, 0xf7, 0x30, 0xb1, 0xb6, 0xc4, 0x55, 0x30, 0xc7,
+	0x38, 0xe2, 0x31, 0x73, 0xce, 0x49, 0x83, 0xe3, 0x48, 0xc9, 0xcb, 0xca, 0xe0, 0x91, 0x88, 0x99,
+	0x3f, 0x82, 0x5c, 0x6f, 0xad, 0x92, 0xb1, 0x03, 0xd2, 0x92, 0x9d, 0xe5, 0xb5, 0xd2, 0xe4, 0x68,
+	0x1d, 0x6d, 0xa4, 0xc9, 0xc0, 0x47, 0x5d, 0x64, 0xa7, 0x11, 0xa5, 0xe7, 0xef, 0x6d, 0x5e, 0xf0,
+	0x43, 0xa0, 0xd7, 0x31, 0xa9, 0xd5, 0x79, 0x7e, 0xb2, 0xa4, 0x6d, 0x4c, 0x55, 0xd4, 0xcd, 0xfc,
+	0x73, 0x02, 0xe4, 0xfb, 0xbb, 0xaa, 0x67, 0x74, 0x8a, 0xb4, 0xee, 0xa2, 0xa4, 0x45, 0x13, 0x35,
+	0x62, 0x2c, 0x5a, 0x64, 0x2b, 0xf2, 0x02, 0x8f, 0x40, 0xd6, 0xc7, 0xd1, 0x45, 0x03, 0x3b, 0x61,
+	0xc2, 0x22, 0x1a, 0x65, 0xb6, 0x57, 0x2d, 0x52, 0x75, 0xad, 0x64, 0x55, 0xac, 0xae, 0xe5, 0x68,
+	0x96, 0xad, 0x9f, 0x05, 0x56, 0x36, 0xcc, 0xf8, 0x9d, 0x0b, 0x2c, 0x80, 0x99, 0x0b, 0xdc, 0x72,
+	0x42, 0xc4, 0xeb, 0xf9, 0xa9, 0xd2, 0xe4, 0xc6, 0x6c, 0x25, 0x7d, 0x81, 0x5b, 0xa7, 0x88, 0xd7,
+	0xe1, 0x22, 0x98, 0x95, 0xd3, 0x4a, 0xde, 0x3f, 0x2d, 0xde, 0x37, 0x23, 0x03, 0xc7, 0x1e, 0x5c,
+	0x01, 0x59, 0xe4, 0xd3, 0x38, 0xe0, 0x8e, 0x7c, 0xbf, 0x2e, 0xf2, 0x19, 0x19, 0x3b, 0x14, 0x53,
+	0xe8, 0x40, 0xa4, 0xfe, 0x74, 0x37, 0xe4, 0x2c, 0x09, 0x99, 0xdf, 0x83, 0x82, 0x98, 0xc7, 0x5e,
+	0xcc, 0xeb, 0x34, 0x22, 0xd7, 0xd8, 0x3b, 0x3e, 0xd8, 0x7b, 0x87, 0xdf, 0xc1, 0xfc, 0x15, 0x18,
+	0x83, 0x88, 0xd4, 0x68, 0x97, 0x41, 0x86, 0xb8, 0xc8, 0x41, 0x9e, 0x17, 0x61, 0xc6, 0x14, 0x07,
+	0x20, 0x2e, 0xda, 0x93, 0x91, 0x64, 0xc6, 0xe7, 0x34, 0x0e, 0x3c, 0x31, 0xe3, 0x99, 0x8a, 0xbc,
+	0x98, 0x39, 0x65, 0xa7, 0x53, 0x14, 0x21, 0xbf, 0xbd, 0xab, 0xe6, 0x09, 0x58, 0xe8, 0x89, 0xaa,
+	0x1e, 0xbb, 0x40, 0x0f, 0x45, 0x64, 0x84, 0x19, 0x64, 0xc9, 0xfe, 0xd4, 0xfd, 0xcb, 0xe5, 0x54,
+	0x45, 0xc1, 0xb7, 0xef, 0x74, 0x30, 0x2d, 0x08, 0xe1, 0x3f, 0x1a, 0xd0, 0xe5, 0x66, 0xc0, 0x4f,
+	0x06, 0x54, 0xf7, 0x5b, 0xdb, 0x58, 0x1b, 0x07, 0x93, 0xe2, 0xcc, 0x6f, 0xef, 0xfe, 0x7f, 0xfd,
+	0xf7, 0xc4, 0x57, 0xf0, 0x4b, 0x7b, 0xd8, 0xb7, 0x88, 0xd9, 0x37, 0x7d, 0xc3, 0xbe, 0xb5, 0x6f,
+	0xc4, 0x8f, 0x7b, 0x0b, 0xff, 0x00, 0x69, 0xe5, 0x3a, 0x38, 0xa6, 0x65, 0x7b, 0x4c, 0xc6, 0xfa,
+	0x58, 0x9c, 0xd2, 0x66, 0x0a, 0x6d, 0x4b, 0xd0, 0x18, 0xae, 0x0d, 0xfe, 0xa7, 0x81, 0x4c, 0x97,
+	0x67, 0xe0, 0xe6, 0x68, 0xf2, 0x6e, 0x3b, 0x1b, 0x9f, 0x3e, 0x0b, 0xab, 0xc4, 0xfc, 0x24, 0xc4,
+	0x1c, 0xc1, 0xc3, 0xa1, 0x62, 0xa4, 0xdf, 0x46, 0x4d, 0xcb, 0xbe, 0x91, 0xce, 0xbd, 0x85, 0xff,
+	0x6a, 0x60, 0xae, 0x67, 0x23, 0xe1, 0x67, 0xc3, 0xc4, 0x0c, 0x72, 0x80, 0xb1, 0xf5, 0x4c, 0xb4,
+	0x12, 0xff, 0x8d, 0x10, 0xbf, 0x0b, 0xbf, 0x18, 0x20, 0x1e, 0xbd, 0xad, 0x70, 0x88, 0x8b, 0x06,
+	0xc9, 0x87, 0xd7, 0x40, 0x97, 0x0b, 0x3a, 0x7c, 0xfb, 0x7a, 0x9c, 0x60, 0xac, 0x8d, 0x83, 0x29,
+	0x5d, 0x2b, 0x42, 0xd7, 0x22, 0x2c, 0x0c, 0xd0, 0x25, 0x4d, 0xb0, 0x7f, 0x72, 0xff, 0x58, 0xd4,
+	0x1e, 0x1e, 0x8b, 0xda, 0xab, 0xc7, 0xa2, 0xf6, 0xd7, 0x53, 0x31, 0xf5, 0xf0, 0x54, 0x4c, 0xbd,
+	0x78, 0x2a, 0xa6, 0x7e, 0xfb, 0xbc, 0x46, 0x78, 0x3d, 0xae, 0x26, 0xdf, 0x33, 0x59, 0xbe, 0x75,
+	0xd5, 0xba, 0x56, 0xa7, 0x30, 0xa2, 0x4d, 0xe2, 0xe1, 0xc8, 0xbe, 0xea, 0x70, 0xf2, 0x56, 0x88,
+	0x59, 0x55, 0x17, 0x7f, 0x88, 0x3b, 0x6f, 0x06, 0x00, 0xa2, 0xbb, 0x28, 0xaa, 0xe2, 0x07, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -553,6 +640,8 @@ type QueryClient interface {
 	EscrowProof(ctx context.Context, in *QueryEscrowProofRequest, opts ...grpc.CallOption) (*QueryEscrowProofResponse, error)
 	// Return the authorized ICA address for a consumer chain, if any
 	AuthorizedICA(ctx context.Context, in *QueryAuthorizedICARequest, opts ...grpc.CallOption) (*QueryAuthorizedICAResponse, error)
+	// Return the escrow policy parameters
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -599,6 +688,15 @@ func (c *queryClient) AuthorizedICA(ctx context.Context, in *QueryAuthorizedICAR
 	return out, nil
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/maany.mintburn.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	Escrow(context.Context, *QueryEscrowRequest) (*QueryEscrowResponse, error)
@@ -607,6 +705,8 @@ type QueryServer interface {
 	EscrowProof(context.Context, *QueryEscrowProofRequest) (*QueryEscrowProofResponse, error)
 	// Return the authorized ICA address for a consumer chain, if any
 	AuthorizedICA(context.Context, *QueryAuthorizedICARequest) (*QueryAuthorizedICAResponse, error)
+	// Return the escrow policy parameters
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -625,6 +725,9 @@ func (*UnimplementedQueryServer) EscrowProof(ctx context.Context, req *QueryEscr
 func (*UnimplementedQueryServer) AuthorizedICA(ctx context.Context, req *QueryAuthorizedICARequest) (*QueryAuthorizedICAResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuthorizedICA not implemented")
 }
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -702,6 +805,25 @@ func _Query_AuthorizedICA_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/maany.mintburn.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "maany.mintburn.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -722,6 +844,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AuthorizedICA",
 			Handler:    _Query_AuthorizedICA_Handler,
 		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "maany/mintburn/v1/query.proto",
@@ -1055,6 +1181,62 @@ func (m *QueryAuthorizedICAResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1211,6 +1393,26 @@ func (m *QueryAuthorizedICAResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2167,6 +2369,139 @@ func (m *QueryAuthorizedICAResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -344,6 +344,95 @@ func (m *MsgMarkEscrowClaimedResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgMarkEscrowClaimedResponse proto.InternalMessageInfo
 
+// MsgUpdateParams updates the mintburn module parameters.
+type MsgUpdateParams struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Params    Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef7f989b267b4432, []int{6}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef7f989b267b4432, []int{7}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgEscrowInitial)(nil), "maany.mintburn.v1.MsgEscrowInitial")
 	proto.RegisterType((*MsgEscrowInitialResponse)(nil), "maany.mintburn.v1.MsgEscrowInitialResponse")
@@ -351,47 +440,55 @@ func init() {
 	proto.RegisterType((*MsgCancelEscrowResponse)(nil), "maany.mintburn.v1.MsgCancelEscrowResponse")
 	proto.RegisterType((*MsgMarkEscrowClaimed)(nil), "maany.mintburn.v1.MsgMarkEscrowClaimed")
 	proto.RegisterType((*MsgMarkEscrowClaimedResponse)(nil), "maany.mintburn.v1.MsgMarkEscrowClaimedResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "maany.mintburn.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "maany.mintburn.v1.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("maany/mintburn/v1/tx.proto", fileDescriptor_ef7f989b267b4432) }
 
 var fileDescriptor_ef7f989b267b4432 = []byte{
-	// 550 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xbf, 0x6f, 0x13, 0x31,
-	0x14, 0xce, 0xe5, 0x97, 0x88, 0xdb, 0xd2, 0xe6, 0x14, 0xd1, 0xcb, 0x51, 0x1d, 0x51, 0x3a, 0x10,
-	0x05, 0x71, 0xa7, 0x14, 0x24, 0x24, 0xc6, 0x46, 0x48, 0x64, 0x08, 0x43, 0x04, 0x0b, 0x03, 0x91,
-	0x73, 0x67, 0x5d, 0x2c, 0x62, 0xfb, 0x64, 0x3b, 0xe1, 0xc2, 0x84, 0x10, 0x0b, 0x1b, 0x7f, 0x4a,
-	0xff, 0x02, 0xe6, 0x8e, 0x1d, 0x99, 0x10, 0x4a, 0x86, 0xfe, 0x1b, 0x28, 0xb6, 0xd3, 0x90, 0x1f,
-	0x88, 0x0e, 0x6c, 0x7e, 0xdf, 0xf7, 0xbd, 0xf7, 0xbe, 0xf7, 0xec, 0x3b, 0xe0, 0x12, 0x08, 0xe9,
-	0x34, 0x20, 0x98, 0xca, 0xc1, 0x98, 0xd3, 0x60, 0xd2, 0x0a, 0x64, 0xea, 0x27, 0x9c, 0x49, 0x66,
-	0x97, 0x15, 0xe7, 0x2f, 0x39, 0x7f, 0xd2, 0x72, 0xbd, 0x90, 0x09, 0xc2, 0x44, 0x30, 0x80, 0x02,
-	0x05, 0x93, 0xd6, 0x00, 0x49, 0xd8, 0x0a, 0x42, 0x86, 0xa9, 0x4e, 0x71, 0x2b, 0x31, 0x8b, 0x99,
-	0x3a, 0x06, 0x8b, 0x93, 0x41, 0x8f, 0x4d, 0x16, 0x11, 0xf1, 0xa2, 0x01, 0x11, 0xb1, 0x21, 0xaa,
-	0x9a, 0xe8, 0xeb, 0x0c, 0x1d, 0x68, 0xaa, 0xfe, 0x35, 0x0b, 0x8e, 0xba, 0x22, 0x7e, 0x21, 0x42,
-	0xce, 0x3e, 0x74, 0x28, 0x96, 0x18, 0x8e, 0xec, 0x7b, 0xa0, 0x28, 0x10, 0x8d, 0x10, 0x77, 0xac,
-	0x9a, 0xd5, 0x28, 0xf5, 0x4c, 0x64, 0x37, 0x41, 0x39, 0x64, 0x54, 0x8c, 0x09, 0xe2, 0xfd, 0x70,
-	0x08, 0x31, 0xed, 0xe3, 0xc8, 0xc9, 0x2a, 0xc9, 0xe1, 0x92, 0x68, 0x2f, 0xf0, 0x4e, 0x64, 0x3f,
-	0x03, 0x45, 0x48, 0xd8, 0x98, 0x4a, 0x27, 0x57, 0xb3, 0x1a, 0x7b, 0x67, 0x55, 0xdf, 0xf4, 0x5d,
-	0xcc, 0xe4, 0x9b, 0x99, 0xfc, 0x36, 0xc3, 0xf4, 0x3c, 0x7f, 0xf9, 0xf3, 0x41, 0xa6, 0x67, 0xe4,
-	0xf6, 0x09, 0x28, 0x71, 0x14, 0xe2, 0x04, 0x23, 0x2a, 0x9d, 0xbc, 0x2a, 0xbe, 0x02, 0xec, 0x53,
-	0x70, 0x80, 0xd2, 0x04, 0xf3, 0x69, 0x7f, 0x88, 0x70, 0x3c, 0x94, 0x4e, 0xa1, 0x66, 0x35, 0xf2,
-	0xbd, 0x7d, 0x0d, 0xbe, 0x54, 0x98, 0xdd, 0x00, 0x47, 0x46, 0x24, 0x31, 0x41, 0xfd, 0x31, 0xc5,
-	0xa9, 0x53, 0x54, 0xba, 0xbb, 0x1a, 0x7f, 0x8d, 0x09, 0x7a, 0x43, 0x71, 0xfa, 0x7c, 0xef, 0xf3,
-	0xf5, 0x45, 0xd3, 0x8c, 0x57, 0x77, 0x81, 0xb3, 0xb9, 0x8a, 0x1e, 0x12, 0x09, 0xa3, 0x02, 0xd5,
-	0x53, 0x70, 0xd8, 0x15, 0x71, 0x1b, 0xd2, 0x10, 0x8d, 0xb4, 0xe2, 0xbf, 0x6c, 0xa9, 0x02, 0x0a,
-	0x11, 0xa2, 0x8c, 0xa8, 0x25, 0x95, 0x7a, 0x3a, 0x58, 0x77, 0x55, 0x05, 0xc7, 0x1b, 0x9d, 0x6f,
-	0x4c, 0x7d, 0xb1, 0x40, 0xa5, 0x2b, 0xe2, 0x2e, 0xe4, 0xef, 0x35, 0xd3, 0x1e, 0x41, 0x4c, 0x50,
-	0xf4, 0x57, 0x6b, 0xf7, 0x41, 0x09, 0x29, 0xe1, 0xca, 0xd2, 0x1d, 0x0d, 0x74, 0xa2, 0xdd, 0xbe,
-	0x73, 0x3b, 0x7d, 0xaf, 0x3b, 0xf4, 0xc0, 0xc9, 0x2e, 0x17, 0x4b, 0x9b, 0x67, 0xdf, 0xb3, 0x20,
-	0xd7, 0x15, 0xb1, 0x0d, 0xc1, 0xc1, 0xfa, 0x3b, 0x3b, 0xf5, 0xb7, 0x9e, 0xbe, 0xbf, 0x79, 0x03,
-	0xee, 0xa3, 0x5b, 0x88, 0x96, 0xad, 0xec, 0x77, 0x60, 0x7f, 0xed, 0x8e, 0xea, 0xbb, 0x93, 0xff,
-	0xd4, 0xb8, 0xcd, 0x7f, 0x6b, 0x6e, 0xea, 0x13, 0x50, 0xde, 0xde, 0xf6, 0xc3, 0xdd, 0x05, 0xb6,
-	0x84, 0x6e, 0x70, 0x4b, 0xe1, 0xb2, 0x9d, 0x5b, 0xf8, 0x74, 0x7d, 0xd1, 0xb4, 0xce, 0x5f, 0x5d,
-	0xce, 0x3c, 0xeb, 0x6a, 0xe6, 0x59, 0xbf, 0x66, 0x9e, 0xf5, 0x6d, 0xee, 0x65, 0xae, 0xe6, 0x5e,
-	0xe6, 0xc7, 0xdc, 0xcb, 0xbc, 0x7d, 0x1a, 0x63, 0x39, 0x1c, 0x0f, 0xfc, 0x90, 0x91, 0x40, 0xd5,
-	0x7e, 0x9c, 0x4e, 0x3f, 0x9a, 0x53, 0xc2, 0xd9, 0x04, 0x47, 0x88, 0x07, 0xe9, 0xea, 0xbf, 0x23,
-	0xa7, 0x09, 0x12, 0x83, 0xa2, 0xfa, 0xf6, 0x9f, 0xfc, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xe0, 0x9c,
-	0x1f, 0x06, 0x96, 0x04, 0x00, 0x00,
+	// 649 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x6e, 0x13, 0x3f,
+	0x10, 0xc7, 0xb3, 0x4d, 0x1b, 0xfd, 0xe2, 0xfe, 0x5f, 0x45, 0xbf, 0x6e, 0x97, 0x6a, 0x5b, 0xa5,
+	0x07, 0xa2, 0xa0, 0xee, 0x2a, 0x05, 0x81, 0xd4, 0x1b, 0x8d, 0x90, 0xe8, 0x21, 0x08, 0x05, 0x7a,
+	0xe1, 0x40, 0xe4, 0xec, 0x5a, 0x1b, 0x8b, 0xda, 0x5e, 0xd9, 0x4e, 0xd8, 0x70, 0x42, 0x88, 0x0b,
+	0x37, 0xc4, 0x03, 0xf0, 0x0c, 0x3d, 0xf0, 0x10, 0x3d, 0x56, 0x9c, 0x38, 0x21, 0xd4, 0x1e, 0xfa,
+	0x1a, 0x28, 0xb6, 0xd3, 0xfc, 0xdb, 0xaa, 0x3d, 0x70, 0xb3, 0x67, 0x3e, 0x33, 0xf3, 0x9d, 0xd9,
+	0x59, 0x03, 0x97, 0x40, 0x48, 0xfb, 0x01, 0xc1, 0x54, 0xb6, 0xbb, 0x9c, 0x06, 0xbd, 0x5a, 0x20,
+	0x53, 0x3f, 0xe1, 0x4c, 0x32, 0x7b, 0x5d, 0xf9, 0xfc, 0xa1, 0xcf, 0xef, 0xd5, 0x5c, 0x2f, 0x64,
+	0x82, 0x30, 0x11, 0xb4, 0xa1, 0x40, 0x41, 0xaf, 0xd6, 0x46, 0x12, 0xd6, 0x82, 0x90, 0x61, 0xaa,
+	0x43, 0xdc, 0x52, 0xcc, 0x62, 0xa6, 0x8e, 0xc1, 0xe0, 0x64, 0xac, 0x1b, 0x26, 0x8a, 0x88, 0x78,
+	0x50, 0x80, 0x88, 0xd8, 0x38, 0x36, 0xb5, 0xa3, 0xa5, 0x23, 0xf4, 0xc5, 0xb8, 0xb6, 0x67, 0x85,
+	0xc5, 0x88, 0x22, 0x81, 0x0d, 0x50, 0xfe, 0x32, 0x07, 0xd6, 0x1a, 0x22, 0x7e, 0x26, 0x42, 0xce,
+	0xde, 0x1f, 0x51, 0x2c, 0x31, 0x3c, 0xb1, 0xff, 0x07, 0x05, 0x81, 0x68, 0x84, 0xb8, 0x63, 0xed,
+	0x58, 0x95, 0x62, 0xd3, 0xdc, 0xec, 0x2a, 0x58, 0x0f, 0x19, 0x15, 0x5d, 0x82, 0x78, 0x2b, 0xec,
+	0x40, 0x4c, 0x5b, 0x38, 0x72, 0xe6, 0x14, 0xb2, 0x3a, 0x74, 0xd4, 0x07, 0xf6, 0xa3, 0xc8, 0x7e,
+	0x02, 0x0a, 0x90, 0xb0, 0x2e, 0x95, 0x4e, 0x7e, 0xc7, 0xaa, 0x2c, 0xee, 0x6f, 0xfa, 0x46, 0xd8,
+	0xa0, 0x69, 0xdf, 0x34, 0xed, 0xd7, 0x19, 0xa6, 0x87, 0xf3, 0x67, 0xbf, 0xb7, 0x73, 0x4d, 0x83,
+	0xdb, 0x5b, 0xa0, 0xc8, 0x51, 0x88, 0x13, 0x8c, 0xa8, 0x74, 0xe6, 0x55, 0xf2, 0x91, 0xc1, 0xde,
+	0x05, 0xcb, 0x28, 0x4d, 0x30, 0xef, 0xb7, 0x3a, 0x08, 0xc7, 0x1d, 0xe9, 0x2c, 0xec, 0x58, 0x95,
+	0xf9, 0xe6, 0x92, 0x36, 0x3e, 0x57, 0x36, 0xbb, 0x02, 0xd6, 0x0c, 0x24, 0x31, 0x41, 0xad, 0x2e,
+	0xc5, 0xa9, 0x53, 0x50, 0xdc, 0x8a, 0xb6, 0xbf, 0xc6, 0x04, 0x1d, 0x53, 0x9c, 0x1e, 0x2c, 0x7e,
+	0xba, 0x3a, 0xad, 0x9a, 0xf6, 0xca, 0x2e, 0x70, 0xa6, 0x47, 0xd1, 0x44, 0x22, 0x61, 0x54, 0xa0,
+	0x72, 0x0a, 0x56, 0x1b, 0x22, 0xae, 0x43, 0x1a, 0xa2, 0x13, 0x4d, 0xfc, 0x93, 0x29, 0x95, 0xc0,
+	0x42, 0x84, 0x28, 0x23, 0x6a, 0x48, 0xc5, 0xa6, 0xbe, 0x4c, 0xaa, 0xda, 0x04, 0x1b, 0x53, 0x95,
+	0xaf, 0x45, 0x7d, 0xb6, 0x40, 0xa9, 0x21, 0xe2, 0x06, 0xe4, 0xef, 0xb4, 0xa7, 0x7e, 0x02, 0x31,
+	0x41, 0xd1, 0x8d, 0xd2, 0xee, 0x81, 0x22, 0x52, 0xe0, 0x48, 0xd2, 0x7f, 0xda, 0x70, 0x14, 0x65,
+	0xeb, 0xce, 0x67, 0xea, 0x9e, 0x54, 0xe8, 0x81, 0xad, 0x2c, 0x15, 0xd7, 0x32, 0xbf, 0x59, 0x6a,
+	0x78, 0xc7, 0x49, 0x04, 0x25, 0x7a, 0x09, 0x39, 0x24, 0xc2, 0x7e, 0x0c, 0x8a, 0xb0, 0x2b, 0x3b,
+	0x8c, 0x63, 0xd9, 0xd7, 0x22, 0x0f, 0x9d, 0x9f, 0x3f, 0xf6, 0x4a, 0x66, 0x49, 0x9e, 0x46, 0x11,
+	0x47, 0x42, 0xbc, 0x92, 0x1c, 0xd3, 0xb8, 0x39, 0x42, 0x07, 0x6b, 0x95, 0xa8, 0x0c, 0xce, 0x9c,
+	0x59, 0xab, 0x99, 0xdf, 0xcb, 0xd7, 0x25, 0x86, 0x6b, 0xa5, 0xf1, 0x83, 0x95, 0x81, 0xe2, 0x51,
+	0x22, 0x33, 0xd6, 0x71, 0x4d, 0x43, 0xbd, 0xfb, 0xdf, 0xf3, 0x20, 0xdf, 0x10, 0xb1, 0x0d, 0xc1,
+	0xf2, 0xe4, 0x7f, 0xb1, 0x9b, 0x51, 0x6c, 0x7a, 0x63, 0xdc, 0x07, 0x77, 0x80, 0x86, 0xa5, 0xec,
+	0xb7, 0x60, 0x69, 0x62, 0xa7, 0xca, 0xd9, 0xc1, 0xe3, 0x8c, 0x5b, 0xbd, 0x9d, 0xb9, 0xce, 0x4f,
+	0xc0, 0xfa, 0xec, 0x76, 0xdc, 0xcf, 0x4e, 0x30, 0x03, 0xba, 0xc1, 0x1d, 0xc1, 0xf1, 0x76, 0x26,
+	0xbe, 0xf2, 0x0d, 0xed, 0x8c, 0x33, 0x6e, 0xf5, 0x76, 0x66, 0x98, 0xdf, 0x5d, 0xf8, 0x78, 0x75,
+	0x5a, 0xb5, 0x0e, 0x5f, 0x9c, 0x5d, 0x78, 0xd6, 0xf9, 0x85, 0x67, 0xfd, 0xb9, 0xf0, 0xac, 0xaf,
+	0x97, 0x5e, 0xee, 0xfc, 0xd2, 0xcb, 0xfd, 0xba, 0xf4, 0x72, 0x6f, 0x1e, 0xc5, 0x58, 0x76, 0xba,
+	0x6d, 0x3f, 0x64, 0x24, 0x50, 0x69, 0xf7, 0xd2, 0xfe, 0x07, 0x73, 0x4a, 0x38, 0xeb, 0xe1, 0x08,
+	0xf1, 0x20, 0x1d, 0xbd, 0x87, 0xb2, 0x9f, 0x20, 0xd1, 0x2e, 0xa8, 0xb7, 0xf0, 0xe1, 0xdf, 0x01,
+	0x00, 0xd2, 0xa2, 0xaa, 0x1d, 0xc7, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -410,6 +507,9 @@ type MsgClient interface {
 	CancelEscrow(ctx context.Context, in *MsgCancelEscrow, opts ...grpc.CallOption) (*MsgCancelEscrowResponse, error)
 	// Mark an escrow as CLAIMED by its escrow_id
 	MarkEscrowClaimed(ctx context.Context, in *MsgMarkEscrowClaimed, opts ...grpc.CallOption) (*MsgMarkEscrowClaimedResponse, error)
+	// UpdateParams updates the escrow policy. Only the module authority (x/gov)
+	// may execute it.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -447,12 +547,24 @@ func (c *msgClient) MarkEscrowClaimed(ctx context.Context, in *MsgMarkEscrowClai
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/maany.mintburn.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	EscrowInitial(context.Context, *MsgEscrowInitial) (*MsgEscrowInitialResponse, error)
 	CancelEscrow(context.Context, *MsgCancelEscrow) (*MsgCancelEscrowResponse, error)
 	// Mark an escrow as CLAIMED by its escrow_id
 	MarkEscrowClaimed(context.Context, *MsgMarkEscrowClaimed) (*MsgMarkEscrowClaimedResponse, error)
+	// UpdateParams updates the escrow policy. Only the module authority (x/gov)
+	// may execute it.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -468,6 +580,9 @@ func (*UnimplementedMsgServer) CancelEscrow(ctx context.Context, req *MsgCancelE
 func (*UnimplementedMsgServer) MarkEscrowClaimed(ctx context.Context, req *MsgMarkEscrowClaimed) (*MsgMarkEscrowClaimedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MarkEscrowClaimed not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -527,6 +642,25 @@ func _Msg_MarkEscrowClaimed_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/maany.mintburn.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "maany.mintburn.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -543,6 +677,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MarkEscrowClaimed",
 			Handler:    _Msg_MarkEscrowClaimed_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "maany/mintburn/v1/tx.proto",
@@ -769,6 +907,69 @@ func (m *MsgMarkEscrowClaimedResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -878,6 +1079,30 @@ func (m *MsgMarkEscrowClaimedResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1543,6 +1768,171 @@ func (m *MsgMarkEscrowClaimedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	feemarketpost "github.com/skip-mev/feemarket/x/feemarket/post"
+
+	"github.com/maany-xyz/maany-provider/x/relayerlane/types"
+)
+
+// LaneFeeMarketKeeper wraps the fee market keeper handed to the fee market
+// ante and post decorators, and discounts the min gas price of the lane txs.
+type LaneFeeMarketKeeper struct {
+	feemarketpost.FeeMarketKeeper
+}
+
+// NewLaneFeeMarketKeeper returns a LaneFeeMarketKeeper wrapping k.
+func NewLaneFeeMarketKeeper(k feemarketpost.FeeMarketKeeper) LaneFeeMarketKeeper {
+	return LaneFeeMarketKeeper{FeeMarketKeeper: k}
+}
+
+// GetMinGasPrice returns the fee market min gas price of denom, discounted if
+// ctx executes a lane tx.
+func (k LaneFeeMarketKeeper) GetMinGasPrice(ctx sdk.Context, denom string) (sdk.DecCoin, error) {
+	price, err := k.FeeMarketKeeper.GetMinGasPrice(ctx, denom)
+	if err != nil {
+		return price, err
+	}
+	if discount, ok := types.LaneDiscount(ctx); ok {
+		price.Amount = price.Amount.Mul(math.LegacyOneDec().Sub(discount))
+	}
+	return price, nil
+}
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-provider/x/relayerlane/types"
+)
+
+// queryServer implements types.QueryServer.
+type queryServer struct {
+	Keeper
+}
+
+// Ensure queryServer implements the generated QueryServer.
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServer returns a types.QueryServer backed by the given keeper.
+func NewQueryServer(k Keeper) types.QueryServer {
+	return queryServer{k}
+}
+
+// Params returns the module parameters.
+func (q queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	return &types.QueryParamsResponse{Params: q.GetParams(sdk.UnwrapSDKContext(ctx))}, nil
+}
+
+// BlockGas returns the lane gas used in the current block and the cap.
+func (q queryServer) BlockGas(ctx context.Context, _ *types.QueryBlockGasRequest) (*types.QueryBlockGasResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.QueryBlockGasResponse{
+		Used: q.GetBlockGas(sdkCtx),
+		Max:  q.GetParams(sdkCtx).MaxBlockGas,
+	}, nil
+}
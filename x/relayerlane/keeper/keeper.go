@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-provider/x/relayerlane/types"
+)
+
+// Keeper holds the relayer lane params and the lane gas used in the block.
+type Keeper struct {
+	cdc       codec.BinaryCodec
+	storeKey  storetypes.StoreKey
+	tStoreKey storetypes.StoreKey
+
+	// the address capable of executing a MsgUpdateParams message, typically x/gov
+	authority string
+}
+
+// NewKeeper creates a new relayerlane Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, storeKey, tStoreKey storetypes.StoreKey, authority string) Keeper {
+	return Keeper{
+		cdc:       cdc,
+		storeKey:  storeKey,
+		tStoreKey: tStoreKey,
+		authority: authority,
+	}
+}
+
+// GetAuthority returns the module authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the module parameters, or the defaults if none are set.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	bz := ctx.KVStore(k.storeKey).Get(types.ParamsKey)
+	if bz == nil {
+		return types.DefaultParams()
+	}
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return fmt.Errorf("invalid params: %w", err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.ParamsKey, k.cdc.MustMarshal(&params))
+	return nil
+}
+
+// GetBlockGas returns the gas limit summed over the lane txs of the block.
+func (k Keeper) GetBlockGas(ctx sdk.Context) uint64 {
+	bz := ctx.TransientStore(k.tStoreKey).Get(types.BlockGasKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// ConsumeBlockGas reserves gas of the lane in the current block. It returns
+// false and reserves nothing if that would exceed max.
+func (k Keeper) ConsumeBlockGas(ctx sdk.Context, gas, max uint64) bool {
+	used := k.GetBlockGas(ctx)
+	if gas > max || used > max-gas {
+		return false
+	}
+	ctx.TransientStore(k.tStoreKey).Set(types.BlockGasKey, sdk.Uint64ToBigEndian(used+gas))
+	return true
+}
+
+// InitGenesis stores the params.
+func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
+	if err := k.SetParams(ctx, gs.Params); err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis returns the params.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		Params: k.GetParams(ctx),
+	}
+}
@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/maany-xyz/maany-provider/app/helpers"
+	"github.com/maany-xyz/maany-provider/x/relayerlane/keeper"
+	"github.com/maany-xyz/maany-provider/x/relayerlane/types"
+)
+
+func TestUpdateParams(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+	k := gaiaApp.RelayerLaneKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	relayer := sdk.AccAddress("relayer_____________").String()
+	params := types.DefaultParams()
+	params.Relayers = []string{relayer}
+	params.Channels = []string{"channel-0"}
+
+	_, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: relayer, Params: params})
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	invalid := params
+	invalid.FeeDiscount = math.LegacyNewDecWithPrec(11, 1)
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: invalid})
+	require.Error(t, err)
+
+	invalid = params
+	invalid.Channels = []string{"channel-0", "channel-0"}
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: invalid})
+	require.Error(t, err)
+
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	require.NoError(t, err)
+	require.Equal(t, params, k.GetParams(ctx))
+}
+
+func TestConsumeBlockGas(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+	k := gaiaApp.RelayerLaneKeeper
+
+	require.True(t, k.ConsumeBlockGas(ctx, 600, 1000))
+	require.False(t, k.ConsumeBlockGas(ctx, 500, 1000))
+	require.True(t, k.ConsumeBlockGas(ctx, 400, 1000))
+	require.Equal(t, uint64(1000), k.GetBlockGas(ctx))
+	require.False(t, k.ConsumeBlockGas(ctx, 2000, 1000))
+}
+
+func TestLaneFeeMarketKeeper(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+	fmk := keeper.NewLaneFeeMarketKeeper(gaiaApp.FeeMarketKeeper)
+
+	fmParams, err := gaiaApp.FeeMarketKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	full, err := fmk.GetMinGasPrice(ctx, fmParams.FeeDenom)
+	require.NoError(t, err)
+	require.True(t, full.Amount.IsPositive())
+
+	discounted, err := fmk.GetMinGasPrice(types.WithLaneDiscount(ctx, math.LegacyNewDecWithPrec(75, 2)), fmParams.FeeDenom)
+	require.NoError(t, err)
+	require.Equal(t, full.Amount.QuoInt64(4), discounted.Amount)
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/maany-xyz/maany-provider/x/relayerlane/types"
+)
+
+// IsLaneTx returns whether tx may be relayed in the lane: all of its signers
+// are registered relayers, it only relays packets on allowed channels and
+// updates clients, and it relays at least one packet.
+func (k Keeper) IsLaneTx(ctx sdk.Context, tx sdk.Tx, params types.Params) bool {
+	if len(params.Relayers) == 0 {
+		return false
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return false
+	}
+	signers, err := sigTx.GetSigners()
+	if err != nil || len(signers) == 0 {
+		return false
+	}
+	for _, signer := range signers {
+		if !params.IsRelayer(sdk.AccAddress(signer).String()) {
+			return false
+		}
+	}
+
+	packets := 0
+	for _, msg := range tx.GetMsgs() {
+		var channelID string
+		switch msg := msg.(type) {
+		case *clienttypes.MsgUpdateClient:
+			continue
+		case *channeltypes.MsgRecvPacket:
+			channelID = msg.Packet.DestinationChannel
+		case *channeltypes.MsgAcknowledgement:
+			channelID = msg.Packet.SourceChannel
+		case *channeltypes.MsgTimeout:
+			channelID = msg.Packet.SourceChannel
+		case *channeltypes.MsgTimeoutOnClose:
+			channelID = msg.Packet.SourceChannel
+		default:
+			return false
+		}
+		if !params.IsAllowedChannel(channelID) {
+			return false
+		}
+		packets++
+	}
+	return packets > 0
+}
@@ -0,0 +1,26 @@
+package post
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-provider/x/relayerlane/types"
+)
+
+// FeeExemptDecorator wraps the fee market deduct decorator and skips it for
+// the fee exempt lane txs, whose fee was neither checked nor escrowed by the
+// ante handler.
+type FeeExemptDecorator struct {
+	feeDecorator sdk.PostDecorator
+}
+
+// NewFeeExemptDecorator returns a FeeExemptDecorator wrapping feeDecorator.
+func NewFeeExemptDecorator(feeDecorator sdk.PostDecorator) FeeExemptDecorator {
+	return FeeExemptDecorator{feeDecorator: feeDecorator}
+}
+
+func (d FeeExemptDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	if types.IsFeeExemptLaneTx(ctx) {
+		return next(ctx, tx, simulate, success)
+	}
+	return d.feeDecorator.PostHandle(ctx, tx, simulate, success, next)
+}
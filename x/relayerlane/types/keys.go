@@ -0,0 +1,20 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "relayerlane"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// TStoreKey is the transient store holding the gas used by the lane in
+	// the current block.
+	TStoreKey = "transient_" + ModuleName
+)
+
+var (
+	ParamsKey = []byte("Params")
+
+	// BlockGasKey is the transient store key of the lane gas used in the block
+	BlockGasKey = []byte{0x01}
+)
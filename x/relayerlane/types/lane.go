@@ -0,0 +1,29 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type laneDiscountKey struct{}
+
+// WithLaneDiscount marks ctx as executing a lane tx whose fee market gas
+// price is discounted by discount. The post handler reads the mark back, so
+// the fee is checked and deducted at the same price.
+func WithLaneDiscount(ctx sdk.Context, discount math.LegacyDec) sdk.Context {
+	return ctx.WithValue(laneDiscountKey{}, discount)
+}
+
+// LaneDiscount returns the discount ctx was marked with, if it executes a lane
+// tx.
+func LaneDiscount(ctx sdk.Context) (math.LegacyDec, bool) {
+	discount, ok := ctx.Value(laneDiscountKey{}).(math.LegacyDec)
+	return discount, ok
+}
+
+// IsFeeExemptLaneTx returns whether ctx executes a lane tx that pays no fee.
+func IsFeeExemptLaneTx(ctx sdk.Context) bool {
+	discount, ok := LaneDiscount(ctx)
+	return ok && discount.Equal(math.LegacyOneDec())
+}
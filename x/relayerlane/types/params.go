@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// DefaultMaxBlockGas is the default cap on the lane gas of a block.
+const DefaultMaxBlockGas = 10_000_000
+
+// DefaultParams registers no relayers, so the lane is disabled. Once relayers
+// are registered lane txs are fee exempt.
+func DefaultParams() Params {
+	return Params{
+		FeeDiscount: math.LegacyOneDec(),
+		MaxBlockGas: DefaultMaxBlockGas,
+	}
+}
+
+// Validate performs validation on the relayerlane module parameters.
+func (p Params) Validate() error {
+	seen := make(map[string]bool, len(p.Relayers))
+	for _, relayer := range p.Relayers {
+		if _, err := sdk.AccAddressFromBech32(relayer); err != nil {
+			return fmt.Errorf("invalid relayer %s: %w", relayer, err)
+		}
+		if seen[relayer] {
+			return fmt.Errorf("duplicate relayer %s", relayer)
+		}
+		seen[relayer] = true
+	}
+	seen = make(map[string]bool, len(p.Channels))
+	for _, channelID := range p.Channels {
+		if err := host.ChannelIdentifierValidator(channelID); err != nil {
+			return fmt.Errorf("invalid channel %s: %w", channelID, err)
+		}
+		if seen[channelID] {
+			return fmt.Errorf("duplicate channel %s", channelID)
+		}
+		seen[channelID] = true
+	}
+	if p.FeeDiscount.IsNil() || p.FeeDiscount.IsNegative() || p.FeeDiscount.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("fee discount must be within [0, 1]: %s", p.FeeDiscount)
+	}
+	return nil
+}
+
+// IsRelayer returns whether addr is a registered relayer.
+func (p Params) IsRelayer(addr string) bool {
+	for _, relayer := range p.Relayers {
+		if relayer == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAllowedChannel returns whether packets on channelID may be relayed in the
+// lane.
+func (p Params) IsAllowedChannel(channelID string) bool {
+	for _, allowed := range p.Channels {
+		if allowed == channelID {
+			return true
+		}
+	}
+	return false
+}
+
+// IsFeeExempt returns whether lane txs pay no fee at all.
+func (p Params) IsFeeExempt() bool {
+	return p.FeeDiscount.Equal(math.LegacyOneDec())
+}
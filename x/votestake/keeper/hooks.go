@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Hooks wraps the votestake keeper to keep the bonded tokens index up to date.
+type Hooks struct {
+	k *Keeper
+}
+
+var _ stakingtypes.StakingHooks = Hooks{}
+
+// Hooks returns the staking hooks of the votestake keeper. The keeper is
+// taken by reference so the hooks can be registered before it is built.
+func (k *Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// BeforeDelegationSharesModified takes the delegation out of the index until
+// AfterDelegationModified counts it again.
+func (h Hooks) BeforeDelegationSharesModified(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	h.k.removeDelegation(sdk.UnwrapSDKContext(ctx), delAddr, valAddr)
+	return nil
+}
+
+// AfterDelegationModified counts the delegation with its current tokens.
+func (h Hooks) AfterDelegationModified(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.k.updateDelegation(sdk.UnwrapSDKContext(ctx), delAddr, valAddr)
+}
+
+// BeforeDelegationRemoved takes the delegation out of the index.
+func (h Hooks) BeforeDelegationRemoved(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	h.k.removeDelegation(sdk.UnwrapSDKContext(ctx), delAddr, valAddr)
+	return nil
+}
+
+// BeforeValidatorSlashed marks the validator so its delegations are recounted
+// at the end of the block, once the slashed tokens are removed.
+func (h Hooks) BeforeValidatorSlashed(ctx context.Context, valAddr sdk.ValAddress, _ math.LegacyDec) error {
+	h.k.markValidatorSlashed(sdk.UnwrapSDKContext(ctx), valAddr)
+	return nil
+}
+
+func (h Hooks) AfterValidatorCreated(_ context.Context, _ sdk.ValAddress) error { return nil }
+
+func (h Hooks) BeforeValidatorModified(_ context.Context, _ sdk.ValAddress) error { return nil }
+
+func (h Hooks) AfterValidatorRemoved(_ context.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterValidatorBonded(_ context.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterValidatorBeginUnbonding(_ context.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeDelegationCreated(_ context.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterUnbondingInitiated(_ context.Context, _ uint64) error { return nil }
+
+func (h Hooks) BeforeTokenizeShareRecordRemoved(_ context.Context, _ uint64) error { return nil }
@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/maany-xyz/maany-provider/x/votestake/types"
+)
+
+// The bonded tokens index keeps, for every delegator, the sum of the tokens of
+// all its delegations. It is updated by the staking hooks whenever a
+// delegation changes. A slash changes the tokens of every delegation to the
+// validator without touching the delegations, so slashed validators are
+// marked and their delegations recounted at the end of the block. Until then
+// BondedTokens corrects the total of a delegator for the marked validators.
+
+// GetDelegatorBonded returns the indexed total of tokens bonded by delAddr. It
+// may be stale for validators slashed in the current block, use BondedTokens.
+func (k Keeper) GetDelegatorBonded(ctx sdk.Context, delAddr sdk.AccAddress) math.Int {
+	return k.getInt(ctx, types.DelegatorBondedKey(delAddr))
+}
+
+// BondedTokens returns the tokens bonded by delAddr over all its delegations.
+func (k Keeper) BondedTokens(ctx sdk.Context, delAddr sdk.AccAddress) (math.Int, error) {
+	total := k.GetDelegatorBonded(ctx, delAddr)
+	for _, valAddr := range k.getSlashedValidators(ctx) {
+		key := types.DelegationTokensKey(delAddr, valAddr)
+		if !ctx.KVStore(k.storeKey).Has(key) {
+			continue
+		}
+		tokens, err := k.delegationTokens(ctx, delAddr, valAddr)
+		if err != nil {
+			return math.ZeroInt(), err
+		}
+		total = total.Sub(k.getInt(ctx, key)).Add(tokens)
+	}
+	return total, nil
+}
+
+// RecountSlashedValidators updates the index for the delegations of the
+// validators slashed in the current block. Only the delegations indexed for
+// the validator are visited, a delegation without tokens is not affected by a
+// slash.
+func (k Keeper) RecountSlashedValidators(ctx sdk.Context) error {
+	for _, valAddr := range k.getSlashedValidators(ctx) {
+		var delAddrs []sdk.AccAddress
+		prefix := types.ValidatorDelegatorsPrefix(valAddr)
+		iter := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+		for ; iter.Valid(); iter.Next() {
+			// skip the prefix and the address length
+			delAddrs = append(delAddrs, sdk.AccAddress(iter.Key()[len(prefix)+1:]))
+		}
+		iter.Close()
+		for _, delAddr := range delAddrs {
+			if err := k.updateDelegation(ctx, delAddr, valAddr); err != nil {
+				return err
+			}
+		}
+		ctx.KVStore(k.storeKey).Delete(types.SlashedValidatorKey(valAddr))
+	}
+	return nil
+}
+
+// RebuildIndex recomputes the bonded tokens index from all delegations.
+func (k Keeper) RebuildIndex(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	for _, prefix := range [][]byte{types.DelegatorBondedPrefix, types.DelegationTokensPrefix, types.SlashedValidatorPrefix, types.ValidatorDelegatorPrefix} {
+		var keys [][]byte
+		iter := storetypes.KVStorePrefixIterator(store, prefix)
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		iter.Close()
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+
+	var err error
+	iterErr := k.stakingKeeper.IterateAllDelegations(ctx, func(delegation stakingtypes.Delegation) bool {
+		var delAddr sdk.AccAddress
+		var valAddr sdk.ValAddress
+		if delAddr, err = sdk.AccAddressFromBech32(delegation.DelegatorAddress); err != nil {
+			return true
+		}
+		if valAddr, err = sdk.ValAddressFromBech32(delegation.ValidatorAddress); err != nil {
+			return true
+		}
+		err = k.updateDelegation(ctx, delAddr, valAddr)
+		return err != nil
+	})
+	return errors.Join(iterErr, err)
+}
+
+// updateDelegation replaces the tokens counted for the delegation of delAddr
+// to valAddr with its current tokens.
+func (k Keeper) updateDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	k.removeDelegation(ctx, delAddr, valAddr)
+	tokens, err := k.delegationTokens(ctx, delAddr, valAddr)
+	if err != nil || tokens.IsZero() {
+		return err
+	}
+	k.setInt(ctx, types.DelegationTokensKey(delAddr, valAddr), tokens)
+	ctx.KVStore(k.storeKey).Set(types.ValidatorDelegatorKey(valAddr, delAddr), []byte{})
+	k.setInt(ctx, types.DelegatorBondedKey(delAddr), k.GetDelegatorBonded(ctx, delAddr).Add(tokens))
+	return nil
+}
+
+// removeDelegation takes the delegation of delAddr to valAddr out of the index.
+func (k Keeper) removeDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	key := types.DelegationTokensKey(delAddr, valAddr)
+	counted := k.getInt(ctx, key)
+	if counted.IsZero() {
+		return
+	}
+	ctx.KVStore(k.storeKey).Delete(key)
+	ctx.KVStore(k.storeKey).Delete(types.ValidatorDelegatorKey(valAddr, delAddr))
+	k.setInt(ctx, types.DelegatorBondedKey(delAddr), k.GetDelegatorBonded(ctx, delAddr).Sub(counted))
+}
+
+// delegationTokens returns the current tokens of the delegation of delAddr to
+// valAddr, or zero if there is none.
+func (k Keeper) delegationTokens(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (math.Int, error) {
+	delegation, err := k.stakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	if errors.Is(err, stakingtypes.ErrNoDelegation) {
+		return math.ZeroInt(), nil
+	}
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+		return math.ZeroInt(), nil
+	}
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+	return validator.TokensFromSharesTruncated(delegation.Shares).TruncateInt(), nil
+}
+
+func (k Keeper) markValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress) {
+	ctx.KVStore(k.storeKey).Set(types.SlashedValidatorKey(valAddr), []byte{})
+}
+
+func (k Keeper) getSlashedValidators(ctx sdk.Context) []sdk.ValAddress {
+	var valAddrs []sdk.ValAddress
+	iter := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.SlashedValidatorPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		// skip the prefix and the address length
+		valAddrs = append(valAddrs, sdk.ValAddress(iter.Key()[len(types.SlashedValidatorPrefix)+1:]))
+	}
+	return valAddrs
+}
+
+func (k Keeper) getInt(ctx sdk.Context, key []byte) math.Int {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return math.ZeroInt()
+	}
+	var amount math.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return amount
+}
+
+func (k Keeper) setInt(ctx sdk.Context, key []byte, amount math.Int) {
+	if amount.IsZero() {
+		ctx.KVStore(k.storeKey).Delete(key)
+		return
+	}
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(key, bz)
+}
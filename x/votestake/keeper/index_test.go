@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/maany-xyz/maany-provider/app/helpers"
+	"github.com/maany-xyz/maany-provider/x/votestake/types"
+)
+
+func TestBondedTokensIndex(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+	k := gaiaApp.VoteStakeKeeper
+	stakingKeeper := gaiaApp.StakingKeeper
+
+	validators, err := stakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	valAddr, err := sdk.ValAddressFromBech32(validators[0].GetOperator())
+	require.NoError(t, err)
+	consAddr, err := validators[0].GetConsAddr()
+	require.NoError(t, err)
+
+	addr, err := gaiaApp.AccountKeeper.Accounts.Indexes.Number.MatchExact(ctx, 0)
+	require.NoError(t, err)
+	delegator := sdk.AccAddress(addr)
+
+	// the tokens of all delegations of the delegator, computed from x/staking
+	expected := func() math.Int {
+		total := math.ZeroInt()
+		delegations, err := stakingKeeper.GetAllDelegatorDelegations(ctx, delegator)
+		require.NoError(t, err)
+		for _, del := range delegations {
+			valAddr, err := sdk.ValAddressFromBech32(del.GetValidatorAddr())
+			require.NoError(t, err)
+			val, err := stakingKeeper.GetValidator(ctx, valAddr)
+			require.NoError(t, err)
+			total = total.Add(val.TokensFromSharesTruncated(del.GetShares()).TruncateInt())
+		}
+		return total
+	}
+	requireIndexed := func() {
+		bonded, err := k.BondedTokens(ctx, delegator)
+		require.NoError(t, err)
+		require.Equal(t, expected(), bonded)
+	}
+
+	// genesis delegations are indexed
+	require.True(t, expected().IsPositive())
+	requireIndexed()
+
+	val, err := stakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	_, err = stakingKeeper.Delegate(ctx, delegator, math.NewInt(1000000), stakingtypes.Unbonded, val, true)
+	require.NoError(t, err)
+	requireIndexed()
+	// the delegation is indexed by validator for the recount after a slash
+	store := ctx.KVStore(gaiaApp.GetKey(types.StoreKey))
+	require.True(t, store.Has(types.ValidatorDelegatorKey(valAddr, delegator)))
+
+	// a slash is accounted for right away and recounted at the end of the block
+	before := k.GetDelegatorBonded(ctx, delegator)
+	power := stakingKeeper.TokensToConsensusPower(ctx, val.GetTokens())
+	require.NoError(t, gaiaApp.SlashingKeeper.Slash(ctx, sdk.ConsAddress(consAddr), math.LegacyNewDecWithPrec(5, 2), power, 0))
+	require.Equal(t, before, k.GetDelegatorBonded(ctx, delegator))
+	require.True(t, expected().LT(before))
+	requireIndexed()
+
+	require.NoError(t, k.RecountSlashedValidators(ctx))
+	require.Equal(t, expected(), k.GetDelegatorBonded(ctx, delegator))
+
+	// the index survives a rebuild
+	require.NoError(t, k.RebuildIndex(ctx))
+	require.Equal(t, expected(), k.GetDelegatorBonded(ctx, delegator))
+
+	delegations, err := stakingKeeper.GetAllDelegatorDelegations(ctx, delegator)
+	require.NoError(t, err)
+	for _, del := range delegations {
+		valAddr, err := sdk.ValAddressFromBech32(del.GetValidatorAddr())
+		require.NoError(t, err)
+		_, _, err = stakingKeeper.Undelegate(ctx, delegator, valAddr, del.GetShares())
+		require.NoError(t, err)
+	}
+	require.True(t, k.GetDelegatorBonded(ctx, delegator).IsZero())
+	require.False(t, store.Has(types.ValidatorDelegatorKey(valAddr, delegator)))
+}
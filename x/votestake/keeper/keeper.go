@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maany-xyz/maany-provider/x/votestake/types"
+)
+
+// Keeper holds the stake threshold for voting on gov proposals.
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+
+	stakingKeeper types.StakingKeeper
+	bankKeeper    types.BankKeeper
+
+	// the address capable of executing a MsgUpdateParams message, typically x/gov
+	authority string
+}
+
+// NewKeeper creates a new votestake Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	stakingKeeper types.StakingKeeper,
+	bankKeeper types.BankKeeper,
+	authority string,
+) Keeper {
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      storeKey,
+		stakingKeeper: stakingKeeper,
+		bankKeeper:    bankKeeper,
+		authority:     authority,
+	}
+}
+
+// GetAuthority returns the module authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the module parameters, or the defaults if none are set.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	bz := ctx.KVStore(k.storeKey).Get(types.ParamsKey)
+	if bz == nil {
+		return types.DefaultParams()
+	}
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return fmt.Errorf("invalid params: %w", err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.ParamsKey, k.cdc.MustMarshal(&params))
+	return nil
+}
+
+// InitGenesis stores the params of the genesis state and builds the bonded
+// tokens index from the delegations imported by x/staking.
+func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
+	if err := k.SetParams(ctx, gs.Params); err != nil {
+		panic(err)
+	}
+	if err := k.RebuildIndex(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis returns the params as a genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{Params: k.GetParams(ctx)}
+}
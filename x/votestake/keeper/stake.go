@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"strings"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/maany-xyz/maany-provider/x/votestake/types"
+)
+
+// VoterStake returns the tokens staked by voter, read from the bonded tokens
+// index. Depending on params, the tokens backing the LSM share tokens held by
+// the voter and the voter's unbonding delegations are counted too, stopping as
+// soon as params.MinStakedTokens is reached or params.MaxDelegationsChecked
+// share token balances and unbonding delegations were checked.
+func (k Keeper) VoterStake(ctx sdk.Context, voter sdk.AccAddress, params types.Params) (math.LegacyDec, error) {
+	bonded, err := k.BondedTokens(ctx, voter)
+	if err != nil {
+		return math.LegacyZeroDec(), err
+	}
+	stakedTokens := math.LegacyNewDecFromInt(bonded)
+	checked := uint32(0)
+	done := func() bool {
+		return stakedTokens.GTE(params.MinStakedTokens) || checked >= params.MaxDelegationsChecked
+	}
+	if done() {
+		return stakedTokens, nil
+	}
+
+	if params.CountLiquidStaked {
+		k.bankKeeper.IterateAccountBalances(ctx, voter, func(coin sdk.Coin) bool {
+			// share token denoms are {validator}/{record id}
+			if !strings.Contains(coin.Denom, "/") {
+				return false
+			}
+			record, err := k.stakingKeeper.GetTokenizeShareRecordByDenom(ctx, coin.Denom)
+			if err != nil {
+				return false
+			}
+			validatorAddr, err := sdk.ValAddressFromBech32(record.Validator)
+			if err != nil {
+				return false
+			}
+			validator, err := k.stakingKeeper.GetValidator(ctx, validatorAddr)
+			if err == nil {
+				// share tokens are minted one for one with delegation shares
+				stakedTokens = stakedTokens.Add(validator.TokensFromSharesTruncated(math.LegacyNewDecFromInt(coin.Amount)))
+			}
+			checked++
+			return done()
+		})
+		if done() {
+			return stakedTokens, nil
+		}
+	}
+
+	if params.CountUnbonding {
+		err = k.stakingKeeper.IterateDelegatorUnbondingDelegations(ctx, voter, func(ubd stakingtypes.UnbondingDelegation) bool {
+			for _, entry := range ubd.Entries {
+				stakedTokens = stakedTokens.Add(math.LegacyNewDecFromInt(entry.Balance))
+			}
+			checked++
+			return done()
+		})
+	}
+	return stakedTokens, err
+}
+
+// CanVote returns whether voter has staked at least params.MinStakedTokens,
+// along with the stake counted.
+func (k Keeper) CanVote(ctx sdk.Context, voter sdk.AccAddress, params types.Params) (bool, math.LegacyDec, error) {
+	if params.MinStakedTokens.IsZero() {
+		return true, math.LegacyZeroDec(), nil
+	}
+	stakedTokens, err := k.VoterStake(ctx, voter, params)
+	if err != nil {
+		return false, stakedTokens, err
+	}
+	return stakedTokens.GTE(params.MinStakedTokens), stakedTokens, nil
+}
@@ -0,0 +1,23 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// StakingKeeper is used to index the tokens bonded by delegators and to count
+// the stake of a voter.
+type StakingKeeper interface {
+	GetValidator(ctx context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error)
+	GetDelegation(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stakingtypes.Delegation, error)
+	IterateAllDelegations(ctx context.Context, cb func(delegation stakingtypes.Delegation) (stop bool)) error
+	IterateDelegatorUnbondingDelegations(ctx context.Context, delegator sdk.AccAddress, cb func(ubd stakingtypes.UnbondingDelegation) (stop bool)) error
+	GetTokenizeShareRecordByDenom(ctx context.Context, denom string) (stakingtypes.TokenizeShareRecord, error)
+}
+
+// BankKeeper is used to find the LSM share tokens held by a voter.
+type BankKeeper interface {
+	IterateAccountBalances(ctx context.Context, addr sdk.AccAddress, cb func(sdk.Coin) bool)
+}
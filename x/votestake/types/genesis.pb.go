@@ -0,0 +1,626 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: maany/votestake/v1/genesis.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the stake an account needs to vote on gov proposals.
+type Params struct {
+	// min_staked_tokens is the amount of the staking denom an account must have
+	// staked to vote. Zero disables the check.
+	MinStakedTokens cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=min_staked_tokens,json=minStakedTokens,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_staked_tokens"`
+	// max_delegations_checked bounds the number of share token balances and
+	// unbonding delegations summed per vote when they are counted. Delegations
+	// are read from the bonded tokens index and are not bounded.
+	MaxDelegationsChecked uint32 `protobuf:"varint,2,opt,name=max_delegations_checked,json=maxDelegationsChecked,proto3" json:"max_delegations_checked,omitempty"`
+	// count_liquid_staked also counts the tokens backing the tokenized (LSM)
+	// share tokens held by the voter.
+	CountLiquidStaked bool `protobuf:"varint,3,opt,name=count_liquid_staked,json=countLiquidStaked,proto3" json:"count_liquid_staked,omitempty"`
+	// count_unbonding also counts the voter's unbonding delegations.
+	CountUnbonding bool `protobuf:"varint,4,opt,name=count_unbonding,json=countUnbonding,proto3" json:"count_unbonding,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_247a1744dbabe070, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxDelegationsChecked() uint32 {
+	if m != nil {
+		return m.MaxDelegationsChecked
+	}
+	return 0
+}
+
+func (m *Params) GetCountLiquidStaked() bool {
+	if m != nil {
+		return m.CountLiquidStaked
+	}
+	return false
+}
+
+func (m *Params) GetCountUnbonding() bool {
+	if m != nil {
+		return m.CountUnbonding
+	}
+	return false
+}
+
+// GenesisState defines the genesis state of the votestake module.
+type GenesisState struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_247a1744dbabe070, []int{1}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "maany.votestake.v1.Params")
+	proto.RegisterType((*GenesisState)(nil), "maany.votestake.v1.GenesisState")
+}
+
+func init() { proto.RegisterFile("maany/votestake/v1/genesis.proto", fileDescriptor_247a1744dbabe070) }
+
+var fileDescriptor_247a1744dbabe070 = []byte{
+	// 382 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0x5f, 0xab, 0xd3, 0x30,
+	0x18, 0xc6, 0x1b, 0x1d, 0x43, 0xe3, 0x9f, 0xb1, 0xaa, 0x58, 0x27, 0x74, 0x65, 0x37, 0xee, 0x66,
+	0x29, 0x53, 0x14, 0xaf, 0xe7, 0x40, 0x2f, 0x06, 0x4a, 0xa7, 0x37, 0x82, 0x94, 0x2c, 0x0d, 0x5d,
+	0xe8, 0x92, 0xd4, 0x26, 0x2d, 0xad, 0x9f, 0xc2, 0x0f, 0xe3, 0x87, 0xd8, 0xe5, 0xf0, 0x4a, 0xbc,
+	0x18, 0x87, 0xed, 0x4b, 0x9c, 0xcb, 0xc3, 0x92, 0x9e, 0x3f, 0x70, 0xee, 0xde, 0xbc, 0xbf, 0x27,
+	0x4f, 0xf2, 0x3e, 0x2f, 0x0c, 0x38, 0xc6, 0xa2, 0x09, 0x2b, 0xa9, 0xa9, 0xd2, 0x38, 0xa3, 0x61,
+	0x35, 0x0d, 0x53, 0x2a, 0xa8, 0x62, 0x0a, 0xe5, 0x85, 0xd4, 0xd2, 0x75, 0x8d, 0x02, 0x5d, 0x29,
+	0x50, 0x35, 0x1d, 0x3c, 0x4d, 0x65, 0x2a, 0x0d, 0x0e, 0x4f, 0x95, 0x55, 0x0e, 0x5e, 0x10, 0xa9,
+	0xb8, 0x54, 0xb1, 0x05, 0xf6, 0x60, 0xd1, 0xe8, 0x1c, 0xc0, 0xee, 0x17, 0x5c, 0x60, 0xae, 0xdc,
+	0x1f, 0xb0, 0xcf, 0x99, 0x88, 0x8d, 0x57, 0x12, 0x6b, 0x99, 0x51, 0xa1, 0x3c, 0x10, 0x80, 0xf1,
+	0xfd, 0xd9, 0x74, 0xbb, 0x1f, 0x3a, 0xff, 0xf7, 0xc3, 0x97, 0xf6, 0xae, 0x4a, 0x32, 0xc4, 0x64,
+	0xc8, 0xb1, 0x5e, 0xa3, 0x05, 0x4d, 0x31, 0x69, 0xe6, 0x94, 0xfc, 0xfd, 0x33, 0x81, 0xad, 0xf5,
+	0x9c, 0x92, 0xa8, 0xc7, 0x99, 0x58, 0x1a, 0xab, 0xaf, 0xc6, 0xc9, 0x7d, 0x07, 0x9f, 0x73, 0x5c,
+	0xc7, 0x09, 0xdd, 0xd0, 0x14, 0x6b, 0x26, 0x85, 0x8a, 0xc9, 0x9a, 0x92, 0x8c, 0x26, 0xde, 0x9d,
+	0x00, 0x8c, 0x1f, 0x45, 0xcf, 0x38, 0xae, 0xe7, 0xd7, 0xf4, 0x83, 0x85, 0x2e, 0x82, 0x4f, 0x88,
+	0x2c, 0x85, 0x8e, 0x37, 0xec, 0x67, 0xc9, 0x92, 0xf6, 0x7f, 0xde, 0xdd, 0x00, 0x8c, 0xef, 0x45,
+	0x7d, 0x83, 0x16, 0x86, 0xd8, 0xd7, 0xdc, 0x57, 0xb0, 0x67, 0xf5, 0xa5, 0x58, 0x49, 0x91, 0x30,
+	0x91, 0x7a, 0x1d, 0xa3, 0x7d, 0x6c, 0xda, 0xdf, 0x2e, 0xbb, 0xa3, 0x4f, 0xf0, 0xe1, 0x47, 0x1b,
+	0xe8, 0x52, 0x63, 0x4d, 0xdd, 0xf7, 0xb0, 0x9b, 0x9b, 0x24, 0xcc, 0xd0, 0x0f, 0x5e, 0x0f, 0xd0,
+	0xed, 0x80, 0x91, 0xcd, 0x6a, 0xd6, 0x39, 0x05, 0x12, 0xb5, 0xfa, 0xd9, 0xe7, 0xed, 0xc1, 0x07,
+	0xbb, 0x83, 0x0f, 0xce, 0x0e, 0x3e, 0xf8, 0x7d, 0xf4, 0x9d, 0xdd, 0xd1, 0x77, 0xfe, 0x1d, 0x7d,
+	0xe7, 0xfb, 0xdb, 0x94, 0xe9, 0x75, 0xb9, 0x42, 0x44, 0xf2, 0xd0, 0xb8, 0x4d, 0xea, 0xe6, 0x57,
+	0x5b, 0xe5, 0x85, 0xac, 0x58, 0x42, 0x8b, 0xb0, 0xbe, 0xb1, 0x65, 0xdd, 0xe4, 0x54, 0xad, 0xba,
+	0x66, 0x39, 0x6f, 0x2e, 0x06, 0x00, 0x24, 0xbb, 0x9a, 0xad, 0x05, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CountUnbonding {
+		i--
+		if m.CountUnbonding {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CountLiquidStaked {
+		i--
+		if m.CountLiquidStaked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxDelegationsChecked != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxDelegationsChecked))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.MinStakedTokens.Size()
+		i -= size
+		if _, err := m.MinStakedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinStakedTokens.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.MaxDelegationsChecked != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxDelegationsChecked))
+	}
+	if m.CountLiquidStaked {
+		n += 2
+	}
+	if m.CountUnbonding {
+		n += 2
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStakedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinStakedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDelegationsChecked", wireType)
+			}
+			m.MaxDelegationsChecked = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDelegationsChecked |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountLiquidStaked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CountLiquidStaked = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountUnbonding", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CountUnbonding = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,59 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "votestake"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	ParamsKey = []byte("Params")
+
+	// DelegatorBondedPrefix indexes the total tokens bonded by a delegator.
+	DelegatorBondedPrefix = []byte{0x01}
+	// DelegationTokensPrefix indexes the tokens of each delegation counted in
+	// the delegator total, so a delegation can be taken out of it again.
+	DelegationTokensPrefix = []byte{0x02}
+	// SlashedValidatorPrefix marks validators slashed in the current block
+	// whose delegations have not been recounted yet.
+	SlashedValidatorPrefix = []byte{0x03}
+	// ValidatorDelegatorPrefix indexes the delegators of the delegations
+	// counted for a validator, to recount them when it is slashed.
+	ValidatorDelegatorPrefix = []byte{0x04}
+)
+
+// DelegatorBondedKey returns the key of the total tokens bonded by delAddr.
+func DelegatorBondedKey(delAddr sdk.AccAddress) []byte {
+	return append(DelegatorBondedPrefix, address.MustLengthPrefix(delAddr)...)
+}
+
+// DelegationTokensKey returns the key of the tokens counted for the
+// delegation of delAddr to valAddr.
+func DelegationTokensKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	key := append(DelegationTokensPrefix, address.MustLengthPrefix(delAddr)...)
+	return append(key, address.MustLengthPrefix(valAddr)...)
+}
+
+// SlashedValidatorKey returns the key marking valAddr as slashed.
+func SlashedValidatorKey(valAddr sdk.ValAddress) []byte {
+	return append(SlashedValidatorPrefix, address.MustLengthPrefix(valAddr)...)
+}
+
+// ValidatorDelegatorsPrefix returns the prefix of the delegators indexed for
+// valAddr.
+func ValidatorDelegatorsPrefix(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorDelegatorPrefix, address.MustLengthPrefix(valAddr)...)
+}
+
+// ValidatorDelegatorKey returns the key indexing the delegation of delAddr to
+// valAddr by validator.
+func ValidatorDelegatorKey(valAddr sdk.ValAddress, delAddr sdk.AccAddress) []byte {
+	return append(ValidatorDelegatorsPrefix(valAddr), address.MustLengthPrefix(delAddr)...)
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// DefaultParams returns the values the vote decorator used before they were
+// moved on chain: 1_000_000 of the staking denom over at most 100 delegations.
+func DefaultParams() Params {
+	return Params{
+		MinStakedTokens:       math.LegacyNewDec(1000000),
+		MaxDelegationsChecked: 100,
+		CountLiquidStaked:     false,
+		CountUnbonding:        false,
+	}
+}
+
+// Validate performs validation on the votestake module parameters.
+func (p Params) Validate() error {
+	if p.MinStakedTokens.IsNil() || p.MinStakedTokens.IsNegative() {
+		return fmt.Errorf("min staked tokens must be non-negative: %s", p.MinStakedTokens)
+	}
+	if p.MaxDelegationsChecked == 0 {
+		return fmt.Errorf("max delegations checked must be positive")
+	}
+	return nil
+}